@@ -0,0 +1,83 @@
+package day01
+
+import (
+	"AoC_2023/lib"
+	"io"
+	"unicode"
+)
+
+func init() {
+	lib.Register(1, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Parse(r io.Reader) ([]string, error) {
+	return readInput(lib.NewScanner(1, r))
+}
+
+func (Solver) Part1(lines []string) int {
+	return numericOnly(lines)
+}
+
+func (Solver) Part2(lines []string) int {
+	return numericOrSpelled(lines)
+}
+
+// Part 1 - Must be a numeric character
+func numericOnly(lines []string) int {
+	total := 0
+	for _, line := range lines {
+		first := 0
+		second := 0
+		lastSeen := ' '
+
+		for _, c := range line {
+			if unicode.IsDigit(c) {
+				if lastSeen == ' ' {
+					first = int(c) - int('0')
+				}
+				lastSeen = c
+			}
+		}
+		second = int(lastSeen) - int('0')
+		total += 10*first + second
+	}
+
+	return total
+}
+
+// Part 2 - Could be a numeric character OR a spelled-out number
+func numericOrSpelled(lines []string) int {
+	total := 0
+	for _, line := range lines {
+		// Spelled digits can overlap, as in "oneight", so the last digit has to
+		// be found from the end rather than by skipping past the first
+		first, ok := digits.First(line)
+		if !ok {
+			continue
+		}
+		last, _ := digits.Last(line)
+
+		total += 10*first.Value + last.Value
+	}
+
+	return total
+}
+
+// Was a trie overkill? Yes. Is Aho-Corasick even more overkill? Also yes.
+var digits = lib.NewAhoCorasick(map[string]int{
+	"1": 1, "2": 2, "3": 3, "4": 4, "5": 5, "6": 6, "7": 7, "8": 8, "9": 9,
+	"one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+	"six": 6, "seven": 7, "eight": 8, "nine": 9,
+})
+
+func readInput(scanner *lib.Scanner) ([]string, error) {
+	lines := make([]string, 0)
+
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	return lines, scanner.Err()
+}
@@ -0,0 +1,56 @@
+package day01
+
+import (
+	"AoC_2023/lib/daytest"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 142, 142)
+}
+
+func TestSpelledDigits(t *testing.T) {
+	tests := []struct {
+		line string
+		want int
+	}{
+		// The lines from the puzzle's part 2 example
+		{"two1nine", 29},
+		{"eightwothree", 83},
+		{"abcone2threexyz", 13},
+		{"xtwone3four", 24},
+		{"4nineeightseven2", 42},
+		{"zoneight234", 14},
+		{"7pqrstsixteen", 76},
+
+		// Spelled digits can share letters, and the last one still counts
+		{"twone", 21},
+		{"oneight", 18},
+		{"eighthree", 83},
+		{"sevenine", 79},
+		{"nineight", 98},
+
+		// A single digit is both the first and the last
+		{"treb7uchet", 77},
+		{"seven", 77},
+
+		// A line without any digits adds nothing
+		{"trebuchet", 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.line, func(t *testing.T) {
+			if got := numericOrSpelled([]string{test.line}); got != test.want {
+				t.Errorf("got %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
@@ -0,0 +1,135 @@
+package day02
+
+import (
+	"AoC_2023/lib"
+	"io"
+)
+
+type Game struct {
+	id      int
+	maxSeen map[string]int
+}
+
+func init() {
+	lib.Register(2, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Parse(r io.Reader) ([]Game, error) {
+	return readInput(lib.NewScanner(2, r))
+}
+
+func (Solver) Part1(games []Game) int {
+	return countPossible(games)
+}
+
+func (Solver) Part2(games []Game) int {
+	return minRequired(games)
+}
+
+// Part 1
+func countPossible(games []Game) int {
+	numPossible := 0
+
+	// See my comment below for why this can't be a constant -- dang it Go!
+	totalInBag := make(map[string]int)
+	totalInBag["green"] = 13
+	totalInBag["red"] = 12
+	totalInBag["blue"] = 14
+
+	for _, game := range games {
+		counts := game.maxSeen
+		possible := true
+
+		possible = possible && counts["green"] <= totalInBag["green"]
+		possible = possible && counts["red"] <= totalInBag["red"]
+		possible = possible && counts["blue"] <= totalInBag["blue"]
+
+		if possible {
+			numPossible += game.id
+		}
+	}
+
+	return numPossible
+}
+
+// Part 2
+func minRequired(games []Game) int {
+	total := 0
+
+	for _, game := range games {
+		counts := game.maxSeen
+		total += counts["green"] * counts["red"] * counts["blue"]
+	}
+
+	return total
+}
+
+func countMaxSeen(scanner *lib.Scanner, rounds lib.Field) (map[string]int, error) {
+
+	// If this was Rust I'd make a constant array of colors and iterate over it,
+	// but since Go doesn't include mutability in its type system it can't do a
+	// compile time check to enforce that values in constant arrays are never changed
+	// and hence it just doesn't support constant arrays. Big thumbs down from me.
+	colorCounts := make(map[string]int)
+	colorCounts["red"] = 0
+	colorCounts["blue"] = 0
+	colorCounts["green"] = 0
+
+	for _, round := range rounds.Split(";") {
+		for _, cubeVariant := range round.Split(",") {
+			spaceSplit := cubeVariant.Fields()
+			if len(spaceSplit) != 2 {
+				return nil, scanner.Errorf(cubeVariant.Column, cubeVariant.Text, "want \"<count> <color>\"")
+			}
+
+			numTaken, color := spaceSplit[0], spaceSplit[1]
+			numTakenInt, err := scanner.Atoi(numTaken)
+			if err != nil {
+				return nil, err
+			}
+
+			// Since all the colors are in the map before the loops, anything missing
+			// from it is a color we've never heard of. If we dynamically inserted, our
+			// map might be missing a color if we happened to never draw it from the bag
+			existingCount, ok := colorCounts[color.Text]
+			if !ok {
+				return nil, scanner.Errorf(color.Column, color.Text, "unknown color")
+			}
+			colorCounts[color.Text] = max(existingCount, numTakenInt)
+		}
+	}
+
+	return colorCounts, nil
+}
+
+func readInput(scanner *lib.Scanner) ([]Game, error) {
+	games := make([]Game, 0)
+
+	for scanner.Scan() {
+		gameInformation, gameActions, found := scanner.Field().Cut(":")
+		if !found {
+			return nil, scanner.Errorf(0, scanner.Text(), "missing ':' after the game number")
+		}
+
+		header := gameInformation.Fields()
+		if len(header) != 2 || header[0].Text != "Game" {
+			return nil, scanner.Errorf(gameInformation.Column, gameInformation.Text, "want \"Game <id>\"")
+		}
+
+		gameId, err := scanner.Atoi(header[1])
+		if err != nil {
+			return nil, err
+		}
+
+		counts, err := countMaxSeen(scanner, gameActions)
+		if err != nil {
+			return nil, err
+		}
+
+		games = append(games, Game{gameId, counts})
+	}
+
+	return games, scanner.Err()
+}
@@ -0,0 +1,40 @@
+package day02
+
+import (
+	"AoC_2023/lib/daytest"
+	"strings"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 8, 2286)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		line   int
+		column int
+	}{
+		{"unknown color", "Game 1: 3 blue, 4 purple", 1, 19},
+		{"bad count", "Game 1: 3 blue\nGame 2: x blue", 2, 9},
+		{"missing count", "Game 1: 3 blue, red", 1, 16},
+		{"missing colon", "Game 1 3 blue", 1, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Solver{}.Parse(strings.NewReader(test.input))
+			daytest.ParseError(t, err, 2, test.line, test.column)
+		})
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
@@ -1,21 +1,47 @@
-package main
+package day03
 
 import (
-	"bufio"
-	"fmt"
-	"os"
+	"AoC_2023/lib"
+	"io"
+	"slices"
 )
 
-func main() {
-	file, err := os.Open("input")
+func init() {
+	lib.Register(3, Solver{})
+}
 
-	if err != nil {
-		panic(err)
-	}
+type Solver struct{}
+
+func (Solver) Parse(r io.Reader) ([][]rune, error) {
+	return createGrid(lib.NewScanner(3, r))
+}
 
-	grid := createGrid(bufio.NewScanner(file))
+func (Solver) Part1(grid [][]rune) int {
+	return part1(grid)
+}
+
+func (Solver) Part2(grid [][]rune) int {
+	return part2(grid)
+}
 
-	fmt.Println(gearRatio(&grid))
+// Both parts blank out numbers as they read them to avoid double counts,
+// so each gets its own copy of the grid to scribble on.
+func part1(grid [][]rune) int {
+	g := cloneGrid(grid)
+	return sumTouchingSymbol(&g)
+}
+
+func part2(grid [][]rune) int {
+	g := cloneGrid(grid)
+	return gearRatio(&g)
+}
+
+func cloneGrid(grid [][]rune) [][]rune {
+	clone := make([][]rune, len(grid))
+	for i, row := range grid {
+		clone[i] = slices.Clone(row)
+	}
+	return clone
 }
 
 // Part 2
@@ -135,7 +161,7 @@ func parseInt(r rune) int {
 	return val
 }
 
-func createGrid(scanner *bufio.Scanner) [][]rune {
+func createGrid(scanner *lib.Scanner) ([][]rune, error) {
 	grid := make([][]rune, 0, 10)
 
 	for scanner.Scan() {
@@ -147,5 +173,5 @@ func createGrid(scanner *bufio.Scanner) [][]rune {
 		grid = append(grid, lineArr)
 	}
 
-	return grid
+	return grid, scanner.Err()
 }
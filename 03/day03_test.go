@@ -0,0 +1,18 @@
+package day03
+
+import (
+	"AoC_2023/lib/daytest"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 4361, 467835)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
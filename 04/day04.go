@@ -0,0 +1,135 @@
+package day04
+
+import (
+	"AoC_2023/lib"
+	"io"
+	"math"
+)
+
+type Scratchcards struct {
+	winningNumbers []map[string]bool
+	ticketNumbers  [][]string
+}
+
+func init() {
+	lib.Register(4, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Parse(r io.Reader) (Scratchcards, error) {
+	return readInput(lib.NewScanner(4, r))
+}
+
+func (Solver) Part1(cards Scratchcards) int {
+	return part1(cards)
+}
+
+func (Solver) Part2(cards Scratchcards) int {
+	return part2(cards)
+}
+
+func part1(cards Scratchcards) int {
+	return countWinnings(&cards.winningNumbers, &cards.ticketNumbers)
+}
+
+func part2(cards Scratchcards) int {
+	return totalCards(&cards.winningNumbers, &cards.ticketNumbers)
+}
+
+// Part 1
+func countWinnings(winningNumbersArr *[]map[string]bool, ticketNumbersArr *[][]string) int {
+	total := 0
+
+	for i, ticketNums := range *ticketNumbersArr {
+		winningNums := (*winningNumbersArr)[i]
+		countInWinning := 0
+		for _, num := range ticketNums {
+			if winningNums[num] {
+				countInWinning++
+			}
+		}
+		if countInWinning > 0 {
+			toAdd := int(math.Pow(2, float64(countInWinning-1)))
+			total += toAdd
+		}
+	}
+
+	return total
+}
+
+// Part 2
+func totalCards(winningNumbersArr *[]map[string]bool, ticketNumbersArr *[][]string) int {
+	numCards := make([]int, len(*ticketNumbersArr))
+	for i := range numCards {
+		numCards[i] = 1
+	}
+
+	for i, ticketNumbers := range *ticketNumbersArr {
+		winningNumbers := (*winningNumbersArr)[i]
+		numFound := 0
+
+		for _, n := range ticketNumbers {
+			if winningNumbers[n] {
+				numFound++
+			}
+		}
+
+		for j := i + 1; j < len(numCards) && j <= i+numFound; j++ {
+			numCards[j] += numCards[i] // One extra j for each copy of i we have
+		}
+	}
+
+	sum := 0
+	for _, n := range numCards {
+		sum += n
+	}
+
+	return sum
+}
+
+func readInput(scanner *lib.Scanner) (Scratchcards, error) {
+	winningNumbers := make([]map[string]bool, 0)
+	ticketNumbers := make([][]string, 0)
+
+	for scanner.Scan() {
+		ticketWinners, numbersOnTicket, err := parseTicket(scanner)
+		if err != nil {
+			return Scratchcards{}, err
+		}
+		winningNumbers = append(winningNumbers, ticketWinners)
+		ticketNumbers = append(ticketNumbers, numbersOnTicket)
+	}
+
+	return Scratchcards{winningNumbers, ticketNumbers}, scanner.Err()
+}
+
+func parseTicket(scanner *lib.Scanner) (map[string]bool, []string, error) {
+	_, allNumbers, found := scanner.Field().Cut(":")
+	if !found {
+		return nil, nil, scanner.Errorf(0, scanner.Text(), "missing ':' after the card number")
+	}
+
+	winning, onTicket, found := allNumbers.Cut("|")
+	if !found {
+		return nil, nil, scanner.Errorf(allNumbers.Column, allNumbers.Text, "missing '|' between the two lists of numbers")
+	}
+
+	winningNumbersSet := make(map[string]bool) // Why is there no set type?? (╯°□°)╯︵ ┻━┻
+	for _, num := range winning.Fields() {
+		if _, err := scanner.Atoi(num); err != nil {
+			return nil, nil, err
+		}
+		winningNumbersSet[num.Text] = true
+	}
+
+	ticketNumbers := make([]string, 0)
+	for _, num := range onTicket.Fields() {
+		if _, err := scanner.Atoi(num); err != nil {
+			return nil, nil, err
+		}
+		ticketNumbers = append(ticketNumbers, num.Text)
+	}
+
+	return winningNumbersSet, ticketNumbers, nil
+}
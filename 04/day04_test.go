@@ -0,0 +1,36 @@
+package day04
+
+import (
+	"AoC_2023/lib/daytest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 13, 30)
+}
+
+func TestCardPoints(t *testing.T) {
+	example, err := os.ReadFile("example")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(example)), "\n")
+	want := []int{8, 2, 2, 1, 0, 0}
+
+	for i, points := range want {
+		if got := part1(daytest.ParseString(t, Solver{}, lines[i])); got != points {
+			t.Errorf("card %d: got %d points, want %d", i+1, got, points)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
@@ -0,0 +1,148 @@
+package day05
+
+import (
+	"AoC_2023/lib"
+	"AoC_2023/lib/parse"
+	"io"
+	"math"
+)
+
+type Almanac struct {
+	seeds []int
+
+	// seed-to-soil, soil-to-fertilizer, and so on down to humidity-to-location
+	maps [7]lib.OffsetMap
+}
+
+func init() {
+	lib.Register(5, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Parse(r io.Reader) (Almanac, error) {
+	return readInput(lib.NewScanner(5, r))
+}
+
+func (Solver) Part1(almanac Almanac) int {
+	return closestSeedPlot(almanac)
+}
+
+func (Solver) Part2(almanac Almanac) int {
+	return closestSeedPlotWithRange(almanac)
+}
+
+// Part 1
+func closestSeedPlot(almanac Almanac) int {
+	closest := math.MaxInt
+	for _, plot := range almanac.seeds {
+		for _, m := range almanac.maps {
+			plot = m.Map(plot)
+		}
+		closest = min(closest, plot)
+	}
+
+	return closest
+}
+
+// Part 2
+// Rather than mapping seeds one at a time, map whole ranges of them. Each map
+// splits a range wherever it crosses between the map's segments, so there are
+// only ever a handful of ranges to push through.
+func closestSeedPlotWithRange(almanac Almanac) int {
+	seedRanges := make([]lib.Range, 0)
+
+	for i := 0; i < len(almanac.seeds); i += 2 {
+		start, length := almanac.seeds[i], almanac.seeds[i+1]
+		seedRanges = append(seedRanges, lib.Range{Start: start, End: start + length})
+	}
+
+	plots := lib.NewIntervalSet(seedRanges...)
+	for _, m := range almanac.maps {
+		plots = m.MapSet(plots)
+	}
+
+	lowest, _ := plots.Min()
+	return lowest
+}
+
+func readInput(scanner *lib.Scanner) (Almanac, error) {
+	seedsList, err := parse.Header(scanner, "seeds")
+	if err != nil {
+		return Almanac{}, err
+	}
+
+	seeds, err := parse.Ints(scanner, seedsList)
+	if err != nil {
+		return Almanac{}, err
+	}
+
+	if len(seeds)%2 != 0 {
+		// Part 2 reads the seeds as start and length pairs
+		return Almanac{}, scanner.Errorf(seedsList.Column, seedsList.Text, "want an even number of seeds")
+	}
+
+	maps := [7]string{
+		"seed-to-soil",
+		"soil-to-fertilizer",
+		"fertilizer-to-water",
+		"water-to-light",
+		"light-to-temperature",
+		"temperature-to-humidity",
+		"humidity-to-location",
+	}
+	almanac := Almanac{seeds: seeds}
+	mapsRead := 0
+
+	err = parse.Sections(scanner, func(section *lib.Scanner) error {
+		section.Scan()
+		if mapsRead == len(maps) {
+			return section.Errorf(0, section.Text(), "unexpected section after the %s map", maps[len(maps)-1])
+		}
+
+		var err error
+		almanac.maps[mapsRead], err = createMap(section, maps[mapsRead])
+		mapsRead++
+		return err
+	})
+	if err != nil {
+		return Almanac{}, err
+	}
+
+	if mapsRead < len(maps) {
+		return Almanac{}, scanner.InputErrorf("missing the %s map", maps[mapsRead])
+	}
+
+	return almanac, nil
+}
+
+// Reads a map's section, whose header line has already been scanned.
+func createMap(section *lib.Scanner, name string) (lib.OffsetMap, error) {
+	if header := name + " map:"; section.Text() != header {
+		return lib.OffsetMap{}, section.Errorf(0, section.Text(), "want %q", header)
+	}
+
+	segments := make([]lib.Segment, 0)
+	for section.Scan() {
+		values, err := parse.Ints(section, section.Field())
+		if err != nil {
+			return lib.OffsetMap{}, err
+		}
+
+		if len(values) != 3 {
+			return lib.OffsetMap{}, section.Errorf(0, section.Text(), "want \"<destination> <source> <length>\"")
+		}
+
+		destStart, sourceStart, length := values[0], values[1], values[2]
+		segments = append(segments, lib.Segment{
+			Source: lib.Range{Start: sourceStart, End: sourceStart + length},
+			Offset: destStart - sourceStart,
+		})
+	}
+
+	m, err := lib.NewOffsetMap(segments...)
+	if err != nil {
+		return lib.OffsetMap{}, section.InputErrorf("%s map: %v", name, err)
+	}
+	return m, nil
+}
@@ -1,22 +1,28 @@
-package main
+package day06
 
 import (
-	"bufio"
-	"fmt"
+	"AoC_2023/lib"
+	"AoC_2023/lib/parse"
+	"io"
 	"math"
-	"os"
-	"strconv"
-	"strings"
 )
 
-func main() {
-	file := must(os.Open("input"))
+func init() {
+	lib.Register(6, Solver{})
+}
+
+type Solver struct{}
 
-	scanner := bufio.NewScanner(file)
-	races := readInput(scanner)
+func (Solver) Parse(r io.Reader) ([]Race, error) {
+	return readInput(lib.NewScanner(6, r))
+}
 
-	fmt.Println("Ways to win divided races:", part1(races))
-	fmt.Println("Ways to win one big race:", part2(races))
+func (Solver) Part1(races []Race) int {
+	return part1(races)
+}
+
+func (Solver) Part2(races []Race) int {
+	return part2(races)
 }
 
 type Race struct {
@@ -60,25 +66,25 @@ func ways(race Race) int {
 	return int(rZero-lZero) + 1
 }
 
-func readInput(scanner *bufio.Scanner) []Race {
-	scanner.Scan()
-	times := strings.Fields(strings.Split(scanner.Text(), ":")[1])
-	scanner.Scan()
-	distances := strings.Fields(strings.Split(scanner.Text(), ":")[1])
+func readInput(scanner *lib.Scanner) ([]Race, error) {
+	times, err := parse.HeaderInts(scanner, "Time")
+	if err != nil {
+		return nil, err
+	}
 
-	races := make([]Race, len(times))
-	for i, t := range times {
-		time := must(strconv.Atoi(t))
-		dist := must(strconv.Atoi(distances[i]))
-		races[i] = Race{time, dist}
+	distances, err := parse.HeaderInts(scanner, "Distance")
+	if err != nil {
+		return nil, err
 	}
 
-	return races
-}
+	if len(times) != len(distances) {
+		return nil, scanner.Errorf(0, scanner.Text(), "got %d distances for %d times", len(distances), len(times))
+	}
 
-func must[T any](val T, err any) T {
-	if err != nil {
-		panic(err)
+	races := make([]Race, len(times))
+	for i := range times {
+		races[i] = Race{times[i], distances[i]}
 	}
-	return val
+
+	return races, nil
 }
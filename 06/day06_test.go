@@ -0,0 +1,37 @@
+package day06
+
+import (
+	"AoC_2023/lib/daytest"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 288, 71503)
+}
+
+func TestWays(t *testing.T) {
+	tests := []struct {
+		race Race
+		want int
+	}{
+		{Race{7, 9}, 4},
+		{Race{15, 40}, 8},
+		// The roots land exactly on 10 and 20, which only tie the record
+		{Race{30, 200}, 9},
+		{Race{71530, 940200}, 71503},
+	}
+
+	for _, test := range tests {
+		if got := ways(test.race); got != test.want {
+			t.Errorf("ways(%v) = %d, want %d", test.race, got, test.want)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
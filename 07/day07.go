@@ -1,11 +1,10 @@
-package main
+package day07
 
 import (
-	"bufio"
-	"fmt"
-	"os"
-	"strconv"
-	"strings"
+	"AoC_2023/lib"
+	"AoC_2023/lib/sortx"
+	"io"
+	"slices"
 )
 
 type HandType int
@@ -26,19 +25,28 @@ type Hand struct {
 	cards    [5]int
 }
 
-func main() {
-	file := must(os.Open("input"))
+func init() {
+	lib.Register(7, Solver{})
+}
+
+type Solver struct{}
 
-	scanner := bufio.NewScanner(file)
-	hands := readInput(scanner)
+func (Solver) Parse(r io.Reader) ([]Hand, error) {
+	return readInput(lib.NewScanner(7, r))
+}
 
-	fmt.Println("Part 1 total winnings:", part1(hands))
-	fmt.Println("Part 2 total winnings:", part2(hands))
+func (Solver) Part1(hands []Hand) int {
+	return part1(hands)
+}
+
+func (Solver) Part2(hands []Hand) int {
+	return part2(hands)
 }
 
 func part1(hands []Hand) int {
 	total := 0
-	quicksort(hands, 0, len(hands)-1)
+	hands = slices.Clone(hands) // Don't reorder the caller's hands
+	sortx.Introsort(hands, compareHands)
 
 	for i, hand := range hands {
 		total += (i + 1) * hand.bet
@@ -54,7 +62,7 @@ func part2(hands []Hand) int {
 		jokerifiedHand[i] = hand.rescoreWithJoker()
 	}
 
-	quicksort(jokerifiedHand, 0, len(hands)-1)
+	sortx.Introsort(jokerifiedHand, compareHands)
 	total := 0
 	for i, hand := range jokerifiedHand {
 		total += (i + 1) * hand.bet
@@ -63,52 +71,11 @@ func part2(hands []Hand) int {
 }
 
 // -------- Helpers --------
-func quicksort(hands []Hand, left int, right int) {
-	if left >= len(hands) || left >= right {
-		return
-	}
-
-	pivot := hands[left]
-	swapDex := left
-	swap(hands, left, right)
-
-	for i := left; i < right; i++ {
-		if hands[i].compareTo(&pivot) < 0 {
-			swap(hands, i, swapDex)
-			swapDex++
-		}
-	}
-	swap(hands, swapDex, right)
-	quicksort(hands, left, swapDex-1)
-	quicksort(hands, swapDex+1, right)
+func compareHands(a, b Hand) int {
+	return a.compareTo(&b)
 }
 
-func swap(hands []Hand, i int, j int) {
-	tmp := hands[i]
-	hands[i] = hands[j]
-	hands[j] = tmp
-}
-
-func NewHand(cardsStr string, betStr string) Hand {
-	cards := [5]int{}
-
-	for i, c := range cardsStr {
-		switch c {
-		case 'A':
-			cards[i] = 14
-		case 'K':
-			cards[i] = 13
-		case 'Q':
-			cards[i] = 12
-		case 'J':
-			cards[i] = 11
-		case 'T':
-			cards[i] = 10
-		default:
-			cards[i] = int(c) - int('0')
-		}
-	}
-
+func NewHand(cards [5]int, bet int) Hand {
 	cardCounts := make(map[int]int)
 	for _, card := range cards {
 		cardCounts[card] += 1
@@ -120,7 +87,6 @@ func NewHand(cardsStr string, betStr string) Hand {
 	}
 
 	handType := scoreHandGroups(groupsOfSize)
-	bet := must(strconv.Atoi(betStr))
 
 	return Hand{bet, handType, cards}
 }
@@ -202,20 +168,55 @@ func scoreHandGroups(groupsOfSize [6]int) HandType {
 	return handType
 }
 
-func readInput(scanner *bufio.Scanner) []Hand {
+func readInput(scanner *lib.Scanner) ([]Hand, error) {
 	hands := make([]Hand, 0)
 
 	for scanner.Scan() {
-		line := strings.Fields(scanner.Text())
-		cards, bet := line[0], line[1]
+		line := scanner.Field().Fields()
+		if len(line) != 2 {
+			return nil, scanner.Errorf(0, scanner.Text(), "want \"<cards> <bet>\"")
+		}
+
+		cards, err := parseCards(scanner, line[0])
+		if err != nil {
+			return nil, err
+		}
+
+		bet, err := scanner.Atoi(line[1])
+		if err != nil {
+			return nil, err
+		}
+
 		hands = append(hands, NewHand(cards, bet))
 	}
-	return hands
+	return hands, scanner.Err()
 }
 
-func must[T any](val T, err any) T {
-	if err != nil {
-		panic(err)
+func parseCards(scanner *lib.Scanner, field lib.Field) ([5]int, error) {
+	cards := [5]int{}
+
+	if len(field.Text) != len(cards) {
+		return cards, scanner.Errorf(field.Column, field.Text, "want %d cards", len(cards))
 	}
-	return val
+
+	for i, c := range field.Text {
+		switch c {
+		case 'A':
+			cards[i] = 14
+		case 'K':
+			cards[i] = 13
+		case 'Q':
+			cards[i] = 12
+		case 'J':
+			cards[i] = 11
+		case 'T':
+			cards[i] = 10
+		case '2', '3', '4', '5', '6', '7', '8', '9':
+			cards[i] = int(c) - int('0')
+		default:
+			return cards, scanner.Errorf(field.Column+i, string(c), "unknown card")
+		}
+	}
+
+	return cards, nil
 }
@@ -0,0 +1,70 @@
+package day07
+
+import (
+	"AoC_2023/lib/daytest"
+	"strings"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 6440, 5905)
+}
+
+func TestRescoreWithJoker(t *testing.T) {
+	tests := []struct {
+		cards string
+		want  HandType
+	}{
+		{"JJJJJ", FiveOfAKind},
+		{"JJJJ2", FiveOfAKind},
+		{"JJJ22", FiveOfAKind},
+		{"JJJ23", FourOfAKind},
+		{"2233J", FullHouse},
+		{"2245J", ThreeOfAKind},
+		{"2345J", OnePair},
+		{"22334", TwoPair},
+		{"23456", HighCard},
+	}
+
+	for _, test := range tests {
+		t.Run(test.cards, func(t *testing.T) {
+			hand := daytest.ParseString(t, Solver{}, test.cards+" 1")[0].rescoreWithJoker()
+
+			if hand.handType != test.want {
+				t.Errorf("got hand type %d, want %d", hand.handType, test.want)
+			}
+
+			for i, c := range test.cards {
+				if c == 'J' && hand.cards[i] != 1 {
+					t.Errorf("joker at %d is worth %d, want 1", i, hand.cards[i])
+				}
+			}
+		})
+	}
+}
+
+func TestJokersAreWeakest(t *testing.T) {
+	// Both are four of a kind with jokers, but the leading joker loses the tie
+	hands := daytest.ParseString(t, Solver{}, "JKKK2 1\nQQQQ2 2")
+	weak, strong := hands[0].rescoreWithJoker(), hands[1].rescoreWithJoker()
+
+	if weak.compareTo(&strong) >= 0 {
+		t.Errorf("JKKK2 should rank below QQQQ2")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Solver{}.Parse(strings.NewReader("32T3K 765\nT55X5 684"))
+
+	if parseErr := daytest.ParseError(t, err, 7, 2, 4); parseErr.Text != "X" {
+		t.Errorf("got text %q, want \"X\"", parseErr.Text)
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
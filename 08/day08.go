@@ -0,0 +1,201 @@
+package day08
+
+import (
+	"AoC_2023/lib"
+	"AoC_2023/lib/numtheory"
+	"AoC_2023/lib/parse"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+const MaxDepthFactor int = 1000
+
+type Direction int
+
+const (
+	Left Direction = iota
+	Right
+)
+
+type Forks = map[string][2]string
+type Graph map[string]*GraphNode
+type GraphNode struct {
+	label string
+	left  *GraphNode
+	right *GraphNode
+}
+
+type Network struct {
+	directions []Direction
+	graph      Graph
+}
+
+func init() {
+	lib.Register(8, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Parse(r io.Reader) (Network, error) {
+	return readInput(lib.NewScanner(8, r))
+}
+
+func (Solver) Part1(network Network) int {
+	return part1(network)
+}
+
+func (Solver) Part2(network Network) int {
+	answer, _ := part2(network)
+	return answer
+}
+
+func (Solver) CheckedPart1(network Network) (int, error) {
+	return part1(network), nil
+}
+
+func (Solver) CheckedPart2(network Network) (int, error) {
+	return part2(network)
+}
+
+func part1(network Network) int {
+	directions, graph := network.directions, network.graph
+	sinkPredicate := func(label string) bool { return "ZZZ" == label }
+	if _, ok := graph["AAA"]; !ok {
+		// Part 2's example has no AAA, so there's nothing to walk
+		return 0
+	}
+	return distanceBetween("AAA", sinkPredicate, directions, graph)
+}
+
+func part2(network Network) (int, error) {
+	directions, graph := network.directions, network.graph
+	starts := make([]string, 0)
+	for label := range graph {
+		if strings.HasSuffix(label, "A") {
+			starts = append(starts, label)
+		}
+	}
+
+	numPaths := len(starts)
+	distancesToSink := make([]int, numPaths)
+	sinkPredicate := func(label string) bool { return strings.HasSuffix(label, "Z") }
+
+	for i, start := range starts {
+		distancesToSink[i] = distanceBetween(start, sinkPredicate, directions, graph)
+	}
+
+	steps, err := numtheory.LCMAll(distancesToSink...)
+	if errors.Is(err, numtheory.ErrOverflow) {
+		return 0, fmt.Errorf("the ghosts meet after %v steps: %w", numtheory.BigLCM(distancesToSink...), err)
+	}
+	return steps, err
+}
+
+// ---------- Helpers ----------
+type Predicate func(string) bool
+
+func distanceBetween(startLabel string, sinkPredicate Predicate, directions []Direction, graph Graph) int {
+	distance, n := 0, len(directions)
+	node := graph[startLabel]
+
+	for !sinkPredicate(node.label) && distance < (n*MaxDepthFactor) {
+		node = node.next(directions[distance%n])
+		distance++
+	}
+
+	return distance
+}
+
+var nodePattern = regexp.MustCompile(`^(?P<origin>[0-9A-Z]{3}) = \((?P<left>[0-9A-Z]{3}), (?P<right>[0-9A-Z]{3})\)$`)
+
+func readInput(scanner *lib.Scanner) (Network, error) {
+	if !scanner.Scan() {
+		return Network{}, scanner.InputErrorf("input is empty")
+	}
+
+	directionsStr := scanner.Text()
+	if directionsStr == "" {
+		return Network{}, scanner.Errorf(0, "", "want a line of L and R directions")
+	}
+	directions := make([]Direction, len(directionsStr))
+	for i, ch := range directionsStr {
+		switch ch {
+		case 'L':
+			directions[i] = Left
+		case 'R':
+			directions[i] = Right
+		default:
+			return Network{}, scanner.Errorf(i+1, string(ch), "unknown direction")
+		}
+	}
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return Network{}, err
+		}
+		return Network{}, scanner.InputErrorf("input ends after the directions")
+	}
+	if scanner.Text() != "" {
+		return Network{}, scanner.Errorf(0, scanner.Text(), "want a blank line after the directions")
+	}
+
+	pathForks := make(Forks)
+	for scanner.Scan() {
+		var node struct{ Origin, Left, Right string }
+		if err := parse.Bind(scanner, nodePattern, &node); err != nil {
+			return Network{}, err
+		}
+
+		pathForks[node.Origin] = [2]string{node.Left, node.Right}
+	}
+	if err := scanner.Err(); err != nil {
+		return Network{}, err
+	}
+
+	// A node that's only ever pointed at would be a dead end with nowhere to go
+	for _, children := range pathForks {
+		for _, label := range children {
+			if _, ok := pathForks[label]; !ok {
+				return Network{}, scanner.InputErrorf("node %s is never defined", label)
+			}
+		}
+	}
+
+	return Network{directions, NewGraph(pathForks)}, nil
+}
+
+func NewGraph(forks Forks) Graph {
+	graph := make(Graph)
+
+	for nodeLabel, children := range forks {
+		leftLabel, rightLabel := children[0], children[1]
+
+		if _, ok := graph[leftLabel]; !ok {
+			graph[leftLabel] = &GraphNode{label: leftLabel}
+		}
+
+		if _, ok := graph[rightLabel]; !ok {
+			graph[rightLabel] = &GraphNode{label: rightLabel}
+		}
+
+		if _, ok := graph[nodeLabel]; !ok {
+			graph[nodeLabel] = &GraphNode{label: nodeLabel}
+		}
+
+		graph[nodeLabel].left = graph[leftLabel]
+		graph[nodeLabel].right = graph[rightLabel]
+	}
+
+	return graph
+}
+
+func (node *GraphNode) next(direction Direction) *GraphNode {
+	if direction == Left {
+		return node.left
+	} else {
+		return node.right
+	}
+}
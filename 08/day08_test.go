@@ -0,0 +1,127 @@
+package day08
+
+import (
+	"AoC_2023/lib/daytest"
+	"AoC_2023/lib/numtheory"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 6, 6)
+}
+
+func TestGhostPaths(t *testing.T) {
+	network := daytest.ParseString(t, Solver{}, `LR
+
+11A = (11B, XXX)
+11B = (XXX, 11Z)
+11Z = (11B, XXX)
+22A = (22B, XXX)
+22B = (22C, 22C)
+22C = (22Z, 22Z)
+22Z = (22B, 22B)
+XXX = (XXX, XXX)`)
+
+	if got, _ := part2(network); got != 6 {
+		t.Errorf("got %d, want 6", got)
+	}
+	if got := part1(network); got != 0 {
+		t.Errorf("got %d for part 1 without an AAA node, want 0", got)
+	}
+}
+
+// Ghosts on loops of coprime lengths only meet once every length has come
+// round, which for these takes more steps than an int can count.
+func TestGhostPathsOverflow(t *testing.T) {
+	lengths := []int{601, 607, 613, 617, 619, 631, 641}
+
+	var input strings.Builder
+	input.WriteString("L\n\n")
+	next := 36 * 36 // Middle labels never end in A or Z, so they can't clash with the starts and sinks
+	label := func() string {
+		for {
+			next++
+			if label := strings.ToUpper(strconv.FormatInt(int64(next), 36)); !strings.HasSuffix(label, "A") && !strings.HasSuffix(label, "Z") {
+				return label
+			}
+		}
+	}
+
+	for i, length := range lengths {
+		start, sink := fmt.Sprintf("G%dA", i), fmt.Sprintf("G%dZ", i)
+		first := label()
+		fmt.Fprintf(&input, "%s = (%s, %s)\n", start, first, first)
+		fmt.Fprintf(&input, "%s = (%s, %s)\n", sink, first, first)
+		for node, step := first, 2; ; step++ {
+			following := sink
+			if step < length {
+				following = label()
+			}
+			fmt.Fprintf(&input, "%s = (%s, %s)\n", node, following, following)
+			if following == sink {
+				break
+			}
+			node = following
+		}
+	}
+
+	network := daytest.ParseString(t, Solver{}, input.String())
+	_, err := Solver{}.CheckedPart2(network)
+	if !errors.Is(err, numtheory.ErrOverflow) {
+		t.Fatalf("got %v, want ErrOverflow", err)
+	}
+	if want := numtheory.BigLCM(lengths...).String(); !strings.Contains(err.Error(), want) {
+		t.Errorf("got %q, want it to mention the %s steps", err, want)
+	}
+}
+
+func TestRepeatingDirections(t *testing.T) {
+	network := daytest.ParseString(t, Solver{}, `RL
+
+AAA = (BBB, CCC)
+BBB = (DDD, EEE)
+CCC = (ZZZ, GGG)
+DDD = (DDD, DDD)
+EEE = (EEE, EEE)
+GGG = (GGG, GGG)
+ZZZ = (ZZZ, ZZZ)`)
+
+	if got := part1(network); got != 2 {
+		t.Errorf("got %d, want 2", got)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		line, column int
+	}{
+		{"empty input", "", 0, 0},
+		{"no directions", "\n\nAAA = (AAA, AAA)", 1, 0},
+		{"unknown direction", "LX\n\nAAA = (AAA, AAA)", 1, 2},
+		{"no separator", "LR\nAAA = (AAA, AAA)", 2, 0},
+		{"only directions", "LR", 0, 0},
+		{"malformed node", "LR\n\nAAA = (AAA AAA)", 3, 0},
+		{"undefined node", "LR\n\nAAA = (BBB, AAA)", 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Solver{}.Parse(strings.NewReader(test.input))
+			daytest.ParseError(t, err, 8, test.line, test.column)
+		})
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
@@ -1,48 +1,58 @@
-package main
+package day09
 
 import (
 	"AoC_2023/lib"
-	"bufio"
-	"fmt"
+	"io"
 	"math"
-	"os"
-	"strconv"
-	"strings"
 )
 
-func main() {
-	file := lib.Must(os.Open("input"))
-	scanner := bufio.NewScanner(file)
-	sequences := readInput(scanner)
-	constants := make([][]float64, len(sequences))
+func init() {
+	lib.Register(9, Solver{})
+}
 
-	for i, seq := range sequences {
-		constants[i] = determineConstants(seq)
-	}
+type Solver struct{}
+
+func (Solver) Parse(r io.Reader) ([][]int, error) {
+	return readInput(lib.NewScanner(9, r))
+}
+
+func (Solver) Part1(sequences [][]int) int {
+	return part1(sequences)
+}
 
-	fmt.Println("Next element sums:", part1(constants))
-	fmt.Println("Previous element sums:", part2(constants))
+func (Solver) Part2(sequences [][]int) int {
+	return part2(sequences)
 }
 
-func part1(constants [][]float64) int {
+func part1(sequences [][]int) int {
 
 	total := 0
-	for _, sequenceConstants := range constants {
+	for _, sequenceConstants := range allConstants(sequences) {
 		total += evalDiscrete(sequenceConstants, len(sequenceConstants))
 	}
 
 	return total
 }
 
-func part2(constants [][]float64) int {
+func part2(sequences [][]int) int {
 	total := 0
-	for _, sequenceConstants := range constants {
+	for _, sequenceConstants := range allConstants(sequences) {
 		// We love when part1's impl answers part2 as well!
 		total += evalDiscrete(sequenceConstants, -1)
 	}
 	return total
 }
 
+func allConstants(sequences [][]int) [][]float64 {
+	constants := make([][]float64, len(sequences))
+
+	for i, seq := range sequences {
+		constants[i] = determineConstants(seq)
+	}
+
+	return constants
+}
+
 func determineConstants(sequence []int) []float64 {
 	// Discrete calculus time! As described in the puzzle, we can
 	// make a table of finite differences, and from the we can
@@ -101,17 +111,20 @@ func evalDiscrete(constants []float64, n int) int {
 	return total
 }
 
-func readInput(scanner *bufio.Scanner) [][]int {
+func readInput(scanner *lib.Scanner) ([][]int, error) {
 	sequences := make([][]int, 0)
 
 	for scanner.Scan() {
-		line := scanner.Text()
 		seq := make([]int, 0)
-		for _, ch := range strings.Fields(line) {
-			seq = append(seq, lib.Must(strconv.Atoi(ch)))
+		for _, field := range scanner.Field().Fields() {
+			n, err := scanner.Atoi(field)
+			if err != nil {
+				return nil, err
+			}
+			seq = append(seq, n)
 		}
 		sequences = append(sequences, seq)
 	}
 
-	return sequences
+	return sequences, scanner.Err()
 }
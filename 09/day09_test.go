@@ -0,0 +1,42 @@
+package day09
+
+import (
+	"AoC_2023/lib/daytest"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 114, 2)
+}
+
+func TestExtrapolateEachSequence(t *testing.T) {
+	tests := []struct {
+		sequence []int
+		next     int
+		previous int
+	}{
+		{[]int{0, 3, 6, 9, 12, 15}, 18, -3},
+		{[]int{1, 3, 6, 10, 15, 21}, 28, 0},
+		{[]int{10, 13, 16, 21, 30, 45}, 68, 5},
+	}
+
+	for _, test := range tests {
+		sequences := [][]int{test.sequence}
+
+		if got := part1(sequences); got != test.next {
+			t.Errorf("next after %v: got %d, want %d", test.sequence, got, test.next)
+		}
+
+		if got := part2(sequences); got != test.previous {
+			t.Errorf("previous before %v: got %d, want %d", test.sequence, got, test.previous)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
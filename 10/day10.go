@@ -0,0 +1,170 @@
+package day10
+
+import (
+	"AoC_2023/lib"
+	"io"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Each tile holds the directions its pipe connects in
+type Maze [][][]lib.Direction
+
+type Sketch struct {
+	start lib.Point
+	maze  Maze
+}
+
+func init() {
+	lib.Register(10, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Parse(r io.Reader) (Sketch, error) {
+	return readInput(lib.NewScanner(10, r))
+}
+
+func (Solver) Part1(sketch Sketch) int {
+	return part1(sketch)
+}
+
+func (Solver) Part2(sketch Sketch) int {
+	return part2(sketch)
+}
+
+func part1(sketch Sketch) int {
+	pointsOnLoop := dfs(sketch.start, sketch.maze)
+	return int(math.Round(float64(len(pointsOnLoop)) / 2.0))
+}
+
+func part2(sketch Sketch) int {
+	start, maze := sketch.start, sketch.maze
+
+	// An implementation of the point-in-polygon algorithm
+	// Needed a refresher, so I'm assuming future me will need it also:
+	// https://en.wikipedia.org/wiki/Point_in_polygon
+	//
+	// Walking the loop in reading order means every gap between two of its
+	// points on the same row is tiles that aren't on the loop, so only the
+	// loop itself needs visiting.
+	total := 0
+	inside := false
+	previous := lib.Point{Row: -1}
+	pointsOnLoop := dfs(start, maze).SortedFunc(func(a, b lib.Point) int {
+		if a.Row != b.Row {
+			return a.Row - b.Row
+		}
+		return a.Col - b.Col
+	})
+
+	for _, point := range pointsOnLoop {
+		if point.Row != previous.Row {
+			inside = false
+		} else if inside {
+			total += point.Col - previous.Col - 1
+		}
+
+		if slices.Contains(maze[point.Row][point.Col], lib.Up) {
+			inside = !inside
+		}
+		previous = point
+	}
+
+	return total
+}
+
+func dfs(start lib.Point, maze Maze) lib.Set[lib.Point] {
+	// Connections are only kept when both pipes agree, so every move stays
+	// inside the maze
+	return lib.Reachable(start, func(cell lib.Point) []lib.Point {
+		next := make([]lib.Point, 0, 2)
+		for _, connection := range maze[cell.Row][cell.Col] {
+			next = append(next, cell.Move(connection, 1))
+		}
+		return next
+	})
+}
+
+func readInput(scanner *lib.Scanner) (Sketch, error) {
+	maze := make(Maze, 0)
+	start := lib.Point{}
+	foundStart := false
+
+	for rowNum := 0; scanner.Scan(); rowNum++ {
+		line := scanner.Text()
+		if rowNum > 0 && len(line) != len(maze[0]) {
+			return Sketch{}, scanner.Errorf(0, line, "row is %d wide, want %d like the first row", len(line), len(maze[0]))
+		}
+
+		row := make([][]lib.Direction, len(line))
+		for colNum, ch := range line {
+			if !strings.ContainsRune("|-LJ7FS.", ch) {
+				return Sketch{}, scanner.Errorf(colNum+1, string(ch), "unknown pipe")
+			}
+
+			row[colNum] = connectionsOf(ch)
+
+			if ch == 'S' {
+				if foundStart {
+					return Sketch{}, scanner.Errorf(colNum+1, string(ch), "second start, the first was on line %d", start.Row+1)
+				}
+				start = lib.Point{Row: rowNum, Col: colNum}
+				foundStart = true
+			}
+		}
+		maze = append(maze, row)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Sketch{}, err
+	}
+
+	if !foundStart {
+		return Sketch{}, scanner.InputErrorf("no start 'S' in the maze")
+	}
+
+	// A pipe only really connects to a neighbor that connects back
+	for row := 0; row < len(maze); row++ {
+		for col := 0; col < len(maze[row]); col++ {
+			validConnections := make([]lib.Direction, 0)
+
+			for _, direction := range maze[row][col] {
+				neighbor := lib.Point{Row: row, Col: col}.Move(direction, 1)
+				if neighbor.Row < 0 || neighbor.Row >= len(maze) || neighbor.Col < 0 || neighbor.Col >= len(maze[row]) {
+					continue
+				}
+
+				if slices.Contains(maze[neighbor.Row][neighbor.Col], direction.Opposite()) {
+					validConnections = append(validConnections, direction)
+				}
+			}
+
+			maze[row][col] = validConnections
+		}
+	}
+
+	return Sketch{start, maze}, nil
+}
+
+func connectionsOf(pipe rune) []lib.Direction {
+	switch pipe {
+	case '|':
+		return []lib.Direction{lib.Up, lib.Down}
+	case '-':
+		return []lib.Direction{lib.Left, lib.Right}
+	case 'L':
+		return []lib.Direction{lib.Up, lib.Right}
+	case 'J':
+		return []lib.Direction{lib.Up, lib.Left}
+	case '7':
+		return []lib.Direction{lib.Left, lib.Down}
+	case 'F':
+		return []lib.Direction{lib.Right, lib.Down}
+	case 'S':
+		return slices.Clone(lib.Directions[:])
+	default:
+		return []lib.Direction{}
+	}
+}
@@ -0,0 +1,81 @@
+package day10
+
+import (
+	"AoC_2023/lib/daytest"
+	"strings"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 4, 1)
+}
+
+func TestMazes(t *testing.T) {
+	tests := []struct {
+		name string
+		part func(Sketch) int
+		maze string
+		want int
+	}{
+		{"winding loop", part1, `..F7.
+.FJ|.
+SJ.L7
+|F--J
+LJ...`, 8},
+		{"enclosed tiles", part2, `...........
+.S-------7.
+.|F-----7|.
+.||.....||.
+.||.....||.
+.|L-7.F-J|.
+.|..|.|..|.
+.L--J.L--J.
+...........`, 4},
+		{"squeezing between pipes", part2, `..........
+.S------7.
+.|F----7|.
+.||....||.
+.||....||.
+.|L-7F-J|.
+.|..||..|.
+.L--JL--J.
+..........`, 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.part(daytest.ParseString(t, Solver{}, test.maze)); got != test.want {
+				t.Errorf("got %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		line, column int
+	}{
+		{"unknown pipe", "S7\n|x", 2, 2},
+		{"second start", "S7\nS|", 2, 1},
+		{"ragged row", ".F7\nS|\n", 2, 0},
+		{"one pipe row", "S7\n|\n", 2, 0},
+		{"blank row", "S7\n\n|J", 2, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Solver{}.Parse(strings.NewReader(test.input))
+			daytest.ParseError(t, err, 10, test.line, test.column)
+		})
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
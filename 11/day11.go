@@ -1,10 +1,8 @@
-package main
+package day11
 
 import (
 	"AoC_2023/lib"
-	"bufio"
-	"fmt"
-	"os"
+	"io"
 )
 
 type Sector int
@@ -24,30 +22,37 @@ type StarChart struct {
 	galaxies []Coordinate
 }
 
-func main() {
-	file := lib.Must(os.Open("input"))
-	scanner := bufio.NewScanner(file)
-	starChart := readInput(scanner)
+func init() {
+	lib.Register(11, Solver{})
+}
+
+type Solver struct{}
 
-	fmt.Println("Pairwise distance:", part1(starChart))
-	fmt.Println("Pairwise distance with expansion:", part2(starChart))
+func (Solver) Parse(r io.Reader) (StarChart, error) {
+	return readInput(lib.NewScanner(11, r))
 }
 
-func part1(starChart StarChart) int {
-	total := 0
+func (Solver) Part1(starChart StarChart) int {
+	return part1(starChart)
+}
 
-	for i, originGalaxy := range starChart.galaxies {
-		for _, destinationGalaxy := range starChart.galaxies[i+1:] {
-			total += expandedManhattanDistance(originGalaxy, destinationGalaxy, starChart, 1)
-		}
-	}
+func (Solver) Part2(starChart StarChart) int {
+	return part2(starChart)
+}
 
-	return total
+func part1(starChart StarChart) int {
+	return sumOfDistances(starChart, 1)
 }
 
 func part2(starChart StarChart) int {
+	return sumOfDistances(starChart, 1000000-1) // Minus 1 to account for the single existing empty row/col
+}
+
+// Sums the distance between every pair of galaxies, where each empty row or
+// column adds expansionFactor extra steps.
+func sumOfDistances(starChart StarChart, expansionFactor int) int {
 	total := 0
-	expansionFactor := 1000000 - 1 // Minus 1 to account for the single existing empty row/col
+
 	for i, originGalaxy := range starChart.galaxies {
 		for _, destinationGalaxy := range starChart.galaxies[i+1:] {
 			total += expandedManhattanDistance(originGalaxy, destinationGalaxy, starChart, expansionFactor)
@@ -81,7 +86,7 @@ func expandedManhattanDistance(origin, dest Coordinate, starChart StarChart, exp
 	return dist
 }
 
-func readInput(scanner *bufio.Scanner) StarChart {
+func readInput(scanner *lib.Scanner) (StarChart, error) {
 	image := make([][]Sector, 0)
 	stars := make([]Coordinate, 0)
 	starNumber := Sector(1)
@@ -94,10 +99,18 @@ func readInput(scanner *bufio.Scanner) StarChart {
 			image = append(image, make([]Sector, len(line)+1))
 		}
 
+		if len(line)+1 != len(image[0]) {
+			return StarChart{}, scanner.Errorf(0, line, "row is %d wide, want %d like the first row", len(line), len(image[0])-1)
+		}
+
 		// len(line)+1 to add a column to track empty rows
 		image = append(image, make([]Sector, len(line)+1))
 
 		for col, ch := range line {
+			if ch != '#' && ch != '.' {
+				return StarChart{}, scanner.Errorf(col+1, string(ch), "want '#' or '.'")
+			}
+
 			if ch == '#' {
 				image[0][col+1] = Occupied
 				image[row+1][0] = Occupied
@@ -108,5 +121,5 @@ func readInput(scanner *bufio.Scanner) StarChart {
 		}
 	}
 
-	return StarChart{image, stars}
+	return StarChart{image, stars}, scanner.Err()
 }
@@ -0,0 +1,37 @@
+package day11
+
+import (
+	"AoC_2023/lib/daytest"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 374, 82000210)
+}
+
+func TestExpansionFactors(t *testing.T) {
+	starChart := daytest.ParseFile(t, Solver{}, "example")
+
+	tests := []struct {
+		timesLarger int
+		want        int
+	}{
+		{2, 374},
+		{10, 1030},
+		{100, 8410},
+	}
+
+	for _, test := range tests {
+		if got := sumOfDistances(starChart, test.timesLarger-1); got != test.want {
+			t.Errorf("%d times larger: got %d, want %d", test.timesLarger, got, test.want)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
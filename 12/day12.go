@@ -0,0 +1,178 @@
+package day12
+
+import (
+	"AoC_2023/lib"
+	"io"
+)
+
+type Spring int
+
+const (
+	Damaged Spring = iota
+	Operational
+	Unknown
+)
+
+type SpringRow struct {
+	springs []Spring
+	runLens []int
+}
+
+// Where countWays is: the next spring to place, the next run to fill and
+// how long the current run of damaged springs is.
+type Position struct {
+	spring, run, runLen int
+}
+
+func init() {
+	lib.Register(12, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Parse(r io.Reader) ([]SpringRow, error) {
+	return readInput(lib.NewScanner(12, r))
+}
+
+func (Solver) Part1(rows []SpringRow) int {
+	return part1(rows)
+}
+
+func (Solver) Part2(rows []SpringRow) int {
+	return part2(rows)
+}
+
+func part1(rows []SpringRow) int {
+	total := 0
+
+	for _, row := range rows {
+		total += countWays(row)
+	}
+
+	return total
+}
+
+func part2(rows []SpringRow) int {
+	total := 0
+
+	// There's definitely a mathematical way to combine the answer from part 1,
+	// but I'm kinda sick of this problem and brute force still takes under a second
+	// with memoization so why bother.
+	for _, row := range rows {
+		total += countWays(unfold(row))
+	}
+
+	return total
+}
+
+func countWays(springRow SpringRow) int {
+	springs, runLens := springRow.springs, springRow.runLens
+
+	// A run never grows past the longest run length, or 1 once all the runs
+	// are placed, so every position packs densely into a flat table
+	springCount, runCount, maxRunLen := len(springs)+2, len(runLens)+1, 2
+	for _, runLen := range runLens {
+		maxRunLen = max(maxRunLen, runLen+1)
+	}
+	index := func(p Position) int {
+		return (p.spring*runCount+p.run)*maxRunLen + p.runLen
+	}
+
+	memo := lib.NewDenseMemo(springCount*runCount*maxRunLen, index, func(recurse func(Position) int, p Position) int {
+		s, g, r := p.spring, p.run, p.runLen
+
+		sp := Operational
+		if s < len(springs) {
+			sp = springs[s]
+		}
+
+		if g == len(runLens) && r > 0 {
+			return 0
+		}
+
+		gs := 0
+		if g < len(runLens) {
+			gs = runLens[g]
+		}
+
+		if r > 0 && r == gs {
+			if sp == Damaged {
+				return 0
+			}
+			return recurse(Position{s + 1, g + 1, 0})
+		}
+
+		if s == len(springs)+1 {
+			if g == len(runLens) {
+				return 1
+			}
+
+			return 0
+		}
+
+		ways := 0
+		if sp == Damaged || sp == Unknown {
+			ways += recurse(Position{s + 1, g, r + 1})
+		}
+		if (sp == Operational || sp == Unknown) && r == 0 {
+			ways += recurse(Position{s + 1, g, 0})
+		}
+		return ways
+	})
+
+	return memo.Get(Position{})
+}
+
+func unfold(row SpringRow) SpringRow {
+	springs, runLens := make([]Spring, 0), make([]int, 0)
+
+	for i := 0; i < 5; i++ {
+		springs = append(springs, row.springs...)
+		springs = append(springs, Unknown)
+		runLens = append(runLens, row.runLens...)
+	}
+
+	lib.PopSlice(&springs) // Remove the last ? from the input
+	return SpringRow{springs, runLens}
+}
+
+func readInput(scanner *lib.Scanner) ([]SpringRow, error) {
+	rows := make([]SpringRow, 0)
+
+	for scanner.Scan() {
+		spl := scanner.Field().Fields()
+		if len(spl) != 2 {
+			return nil, scanner.Errorf(0, scanner.Text(), "want \"<springs> <run lengths>\"")
+		}
+
+		springs := make([]Spring, 0)
+		for i, ch := range spl[0].Text {
+			switch ch {
+			case '#':
+				springs = append(springs, Damaged)
+			case '.':
+				springs = append(springs, Operational)
+			case '?':
+				springs = append(springs, Unknown)
+			default:
+				return nil, scanner.Errorf(spl[0].Column+i, string(ch), "unknown spring")
+			}
+		}
+
+		runLens := make([]int, 0)
+		for _, num := range spl[1].Split(",") {
+			runLen, err := scanner.Atoi(num)
+			if err != nil {
+				return nil, err
+			}
+			if runLen < 1 {
+				return nil, scanner.Errorf(num.Column, num.Text, "want a run length of at least 1")
+			}
+			runLens = append(runLens, runLen)
+		}
+
+		rows = append(rows, SpringRow{springs, runLens})
+	}
+
+	return rows, scanner.Err()
+}
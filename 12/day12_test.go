@@ -0,0 +1,69 @@
+package day12
+
+import (
+	"AoC_2023/lib/daytest"
+	"strings"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 21, 525152)
+}
+
+func TestEachRow(t *testing.T) {
+	tests := []struct {
+		row      string
+		folded   int
+		unfolded int
+	}{
+		{"???.### 1,1,3", 1, 1},
+		{".??..??...?##. 1,1,3", 4, 16384},
+		{"?#?#?#?#?#?#?#? 1,3,1,6", 1, 1},
+		{"????.#...#... 4,1,1", 1, 16},
+		{"????.######..#####. 1,6,5", 4, 2500},
+		{"?###???????? 3,2,1", 10, 506250},
+	}
+
+	for _, test := range tests {
+		t.Run(test.row, func(t *testing.T) {
+			rows := daytest.ParseString(t, Solver{}, test.row)
+
+			if got := part1(rows); got != test.folded {
+				t.Errorf("folded: got %d, want %d", got, test.folded)
+			}
+
+			if got := part2(rows); got != test.unfolded {
+				t.Errorf("unfolded: got %d, want %d", got, test.unfolded)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		line   int
+		column int
+	}{
+		{"zero run length", "#### 0", 1, 6},
+		{"negative run length", "???.### 1,1,3\n#.# 1,-1", 2, 7},
+		{"unknown spring", "?x? 1", 1, 2},
+		{"missing run lengths", "???", 1, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Solver{}.Parse(strings.NewReader(test.input))
+			daytest.ParseError(t, err, 12, test.line, test.column)
+		})
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
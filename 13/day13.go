@@ -0,0 +1,213 @@
+package day13
+
+import (
+	"AoC_2023/lib"
+	"AoC_2023/lib/parse"
+	"io"
+)
+
+type Terrain int
+
+const (
+	Empty Terrain = iota
+	Ash
+	Rock
+)
+
+type Landscape [][]Terrain
+
+type Direction int
+
+const (
+	Row Direction = iota
+	Column
+)
+
+func init() {
+	lib.Register(13, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Parse(r io.Reader) ([]Landscape, error) {
+	return readInput(lib.NewScanner(13, r))
+}
+
+func (Solver) Part1(landscapes []Landscape) int {
+	return part1(landscapes)
+}
+
+func (Solver) Part2(landscapes []Landscape) int {
+	return part2(landscapes)
+}
+
+func part1(landscapes []Landscape) int {
+	summary := 0
+
+	for _, landscape := range landscapes {
+		n, m := len(landscape), len(landscape[0])
+		rowPalindromes, colPalindromes := make([][]int, n), make([][]int, m)
+
+		for i := range landscape {
+			rowPalindromes[i] = palindromeLengths(landscape, i, Row)
+		}
+
+		for j := range landscape[0] {
+			colPalindromes[j] = palindromeLengths(landscape, j, Column)
+		}
+
+		for i := 0; i < n; i++ {
+			for j := 0; j < m; j++ {
+				// Even indices are between two rows or columns, which is where all the mirrors must
+				// be. Odd indices are palindromes centered on a character from the input, so we
+				// can just ignore them.
+				rowPalindromes[0][2*j+1] = 0
+				colPalindromes[0][2*i+1] = 0
+
+				rowPalindromes[0][2*j] = min(rowPalindromes[0][2*j], rowPalindromes[i][2*j])
+				colPalindromes[0][2*i] = min(colPalindromes[0][2*i], colPalindromes[j][2*i])
+			}
+		}
+
+		for i, l := range rowPalindromes[0] {
+			if l > 1 && (i+l == (2*m) || i-l == 0) {
+				summary += i / 2
+				break
+			}
+		}
+
+		for j, l := range colPalindromes[0] {
+			if l > 1 && (j+l == (2*n) || j-l == 0) {
+				summary += 100 * j / 2
+				break
+			}
+		}
+	}
+
+	return summary
+}
+
+func part2(landscapes []Landscape) int {
+	summary := 0
+
+	for _, landscape := range landscapes {
+		rocks := lib.NewBitGrid(len(landscape), len(landscape[0]))
+		for i, row := range landscape {
+			for j, terrain := range row {
+				if terrain == Rock {
+					rocks.Add(lib.Point{Row: i, Col: j})
+				}
+			}
+		}
+
+		// The smudge is the one square where the two sides of the mirror
+		// differ, so the rows or columns it reflects add up to a distance of 1
+		if j, found := smudgedMirror(rocks.Cols(), rocks.ColDistance); found {
+			summary += j
+		}
+		if i, found := smudgedMirror(rocks.Rows(), rocks.RowDistance); found {
+			summary += 100 * i
+		}
+	}
+
+	return summary
+}
+
+// Looks for a mirror between two of n rows or columns, returning how many
+// come before it.
+func smudgedMirror(n int, distance func(a, b int) int) (int, bool) {
+	for mirror := 1; mirror < n; mirror++ {
+		differences := 0
+		for a, b := mirror-1, mirror; a >= 0 && b < n && differences <= 1; a, b = a-1, b+1 {
+			differences += distance(a, b)
+		}
+
+		if differences == 1 {
+			return mirror, true
+		}
+	}
+
+	return 0, false
+}
+
+func palindromeLengths(landscape Landscape, index int, direction Direction) []int {
+	interspersed := intersperse(landscape, index, direction)
+	center, radius, n := 0, 0, len(interspersed)
+	palindromeRadii := make([]int, len(interspersed))
+
+	for center < n {
+
+		for center < n &&
+			center+radius+1 < n &&
+			center-radius-1 > -1 &&
+			interspersed[center-radius-1] == interspersed[center+radius+1] {
+			radius += 1
+		}
+
+		palindromeRadii[center] = radius
+
+		oldCenter, oldRadius := center, radius
+		center, radius = center+1, 0
+
+		for center <= oldCenter+oldRadius {
+			mirroredCenter := oldCenter - (center - oldCenter)
+			maxMirroredRadius := oldCenter + oldRadius - center
+
+			if palindromeRadii[mirroredCenter] < maxMirroredRadius {
+				palindromeRadii[center] = palindromeRadii[mirroredCenter]
+				center += 1
+			} else if palindromeRadii[mirroredCenter] > maxMirroredRadius {
+				palindromeRadii[center] = maxMirroredRadius
+				center += 1
+			} else {
+				radius = maxMirroredRadius
+				break
+			}
+		}
+	}
+
+	return palindromeRadii
+}
+
+func intersperse(landscape Landscape, index int, direction Direction) []Terrain {
+	n := len(landscape)
+	if direction == Row {
+		n = len(landscape[0])
+	}
+
+	interspersed := make([]Terrain, 2*n+1)
+	interspersed[0] = Empty
+	for i := 0; i < n; i++ {
+		var terrain Terrain
+		if direction == Row {
+			terrain = landscape[index][i]
+		} else {
+			terrain = landscape[i][index]
+		}
+		interspersed[2*i+1] = terrain
+		interspersed[2*i+2] = Empty
+	}
+
+	return interspersed
+}
+
+func readInput(scanner *lib.Scanner) ([]Landscape, error) {
+	landscapes := make([]Landscape, 0)
+
+	err := parse.Sections(scanner, func(section *lib.Scanner) error {
+		landscape, err := parse.Grid(section, func(ch rune) (Terrain, bool) {
+			switch ch {
+			case '.':
+				return Ash, true
+			case '#':
+				return Rock, true
+			}
+			return Empty, false
+		})
+
+		landscapes = append(landscapes, landscape)
+		return err
+	})
+
+	return landscapes, err
+}
@@ -0,0 +1,67 @@
+package day13
+
+import (
+	"AoC_2023/lib/daytest"
+	"math/rand"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 405, 400)
+}
+
+func TestEachLandscape(t *testing.T) {
+	landscapes := daytest.ParseFile(t, Solver{}, "example")
+
+	tests := []struct {
+		name    string
+		clean   int
+		smudged int
+	}{
+		{"vertical mirror", 5, 300},
+		{"horizontal mirror", 400, 100},
+	}
+
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			landscape := landscapes[i : i+1]
+
+			if got := part1(landscape); got != test.clean {
+				t.Errorf("clean: got %d, want %d", got, test.clean)
+			}
+
+			if got := part2(landscape); got != test.smudged {
+				t.Errorf("smudged: got %d, want %d", got, test.smudged)
+			}
+		})
+	}
+}
+
+// Used to be limited to 32 rows and columns by packing flags into an int.
+func TestLargeLandscape(t *testing.T) {
+	rng := rand.New(rand.NewSource(13))
+	const rows, cols, mirror = 70, 130, 35
+
+	// Random rows above the mirror, reflected below it
+	landscape := make(Landscape, rows)
+	for i := 0; i < mirror; i++ {
+		landscape[i] = make([]Terrain, cols)
+		for j := range landscape[i] {
+			landscape[i][j] = Ash + Terrain(rng.Intn(2))
+		}
+		landscape[rows-1-i] = append([]Terrain(nil), landscape[i]...)
+	}
+	landscape[50][100] = Ash + Rock - landscape[50][100] // The smudge
+
+	if got := part2([]Landscape{landscape}); got != 100*mirror {
+		t.Errorf("got %d, want %d", got, 100*mirror)
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
@@ -0,0 +1,114 @@
+package day14
+
+import (
+	"AoC_2023/lib"
+	"io"
+)
+
+type Rock int
+
+const (
+	Empty Rock = iota
+	Square
+	Round
+)
+
+var legend = lib.Legend[Rock]{
+	'.': Empty,
+	'#': Square,
+	'O': Round,
+}
+
+func init() {
+	lib.Register(14, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Parse(r io.Reader) (lib.Grid[Rock], error) {
+	return lib.ReadGridLegend(lib.NewScanner(14, r), legend)
+}
+
+func (Solver) Part1(rocks lib.Grid[Rock]) int {
+	return part1(rocks)
+}
+
+func (Solver) Part2(rocks lib.Grid[Rock]) int {
+	return part2(rocks)
+}
+
+// Rather than altering the grid, we can just track where the next
+// round rock should stop based on the other rocks in the same column
+// closer to the north edge.
+func part1(rocks lib.Grid[Rock]) int {
+	nextStop := make([]int, rocks.Cols())
+	size, load := rocks.Rows(), 0
+
+	for row := 0; row < rocks.Rows(); row++ {
+		for col, rock := range rocks.Row(row) {
+			switch rock {
+			case Square:
+				nextStop[col] = row + 1
+			case Round:
+				load += (size - nextStop[col])
+				nextStop[col]++
+			}
+		}
+	}
+
+	return load
+}
+
+func part2(rocks lib.Grid[Rock]) int {
+	// Spin cycles move the rocks in place, so each step works on a copy
+	spin := func(rocks lib.Grid[Rock]) lib.Grid[Rock] { return cycle(rocks.Clone()) }
+	rocks = lib.FindCycle(rocks, spin, key).At(1_000_000_000)
+
+	// Okay, looks like altering the grid was possibly the way to go for part 1
+	load := 0
+	for _, rock := range lib.FindAll(rocks, Round) {
+		load += rocks.Rows() - rock.Row
+	}
+
+	return load
+}
+
+// Tilts north, west, south and then east. Turning the grid clockwise after
+// each tilt brings the next edge to the top, so every tilt is a north tilt,
+// and after four turns the grid is the right way up again.
+func cycle(rocks lib.Grid[Rock]) lib.Grid[Rock] {
+	for i := 0; i < 4; i++ {
+		tiltNorth(rocks)
+		rocks = rocks.RotateClockwise()
+	}
+	return rocks
+}
+
+func tiltNorth(rocks lib.Grid[Rock]) {
+	nextStop := make([]int, rocks.Cols())
+
+	for i := 0; i < rocks.Rows(); i++ {
+		row := rocks.Row(i)
+		for j, rock := range row {
+			switch rock {
+			case Square:
+				nextStop[j] = i + 1
+			case Round:
+				row[j] = Empty
+				rocks.Row(nextStop[j])[j] = Round
+				nextStop[j]++
+			}
+		}
+	}
+}
+
+// One byte per cell, so two arrangements only share a key if they're equal.
+func key(rocks lib.Grid[Rock]) string {
+	cells := make([]byte, 0, rocks.Rows()*rocks.Cols())
+	for i := 0; i < rocks.Rows(); i++ {
+		for _, rock := range rocks.Row(i) {
+			cells = append(cells, byte(rock))
+		}
+	}
+	return string(cells)
+}
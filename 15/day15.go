@@ -0,0 +1,150 @@
+package day15
+
+import (
+	"AoC_2023/lib"
+	"io"
+	"regexp"
+	"slices"
+)
+
+const HASH_PRIME int = 17
+const HASH_MODULO int = 256
+
+type Lense struct {
+	label       string
+	focalLength int
+}
+
+type Step struct {
+	text        string // The step as written, which is what part 1 hashes
+	label       string
+	remove      bool
+	focalLength int
+}
+
+func init() {
+	lib.Register(15, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Parse(r io.Reader) ([]Step, error) {
+	return readInput(lib.NewScanner(15, r))
+}
+
+func (Solver) Part1(steps []Step) int {
+	return part1(steps)
+}
+
+func (Solver) Part2(steps []Step) int {
+	return part2(steps)
+}
+
+func part1(steps []Step) int {
+	total := 0
+
+	for _, s := range steps {
+		total += hash(s.text)
+	}
+
+	return total
+}
+
+func part2(steps []Step) int {
+	focusingPower := 0
+
+	boxes := make([][]Lense, 256)
+	for i := 0; i < 256; i++ {
+		boxes[i] = make([]Lense, 0)
+	}
+
+	for _, s := range steps {
+		boxNo := hash(s.label)
+
+		if s.remove {
+			remove(&boxes[boxNo], s.label)
+		} else {
+			insert(&boxes[boxNo], Lense{s.label, s.focalLength})
+		}
+	}
+
+	for i, box := range boxes {
+		for j, lense := range box {
+			focusingPower += (i + 1) * (j + 1) * lense.focalLength
+		}
+	}
+
+	return focusingPower
+}
+
+func insert(box *[]Lense, lense Lense) {
+	b := *box
+	if index := slices.IndexFunc(b, hasLabel(lense.label)); index > -1 {
+		b[index] = lense
+	} else {
+		*box = append(*box, lense)
+	}
+}
+
+func remove(box *[]Lense, label string) {
+	b := *box
+	if index := slices.IndexFunc(b, hasLabel(label)); index > -1 {
+		for i := index; i < len(b)-1; i++ {
+			b[i] = b[i+1]
+		}
+		lib.PopSlice(box)
+	}
+}
+
+type LensePredicate func(Lense) bool
+
+func hasLabel(label string) LensePredicate {
+	return func(l Lense) bool {
+		return l.label == label
+	}
+}
+
+func hash(s string) int {
+	hash := 0
+
+	for _, ch := range s {
+		hash += int(ch)
+		hash *= HASH_PRIME
+		hash = hash % HASH_MODULO
+	}
+
+	return hash
+}
+
+func readInput(scanner *lib.Scanner) ([]Step, error) {
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, scanner.InputErrorf("input is empty")
+	}
+
+	stepPattern := regexp.MustCompile("^[a-z]+(=[0-9]+|-)$")
+	fields := scanner.Field().Split(",")
+	steps := make([]Step, len(fields))
+
+	for i, step := range fields {
+		if !stepPattern.MatchString(step.Text) {
+			return nil, scanner.Errorf(step.Column, step.Text, "want \"<label>=<focal length>\" or \"<label>-\"")
+		}
+
+		label, focalLength, found := step.Cut("=")
+		if !found {
+			steps[i] = Step{text: step.Text, label: step.Text[:len(step.Text)-1], remove: true}
+			continue
+		}
+
+		n, err := scanner.Atoi(focalLength)
+		if err != nil {
+			return nil, err
+		}
+		steps[i] = Step{text: step.Text, label: label.Text, focalLength: n}
+	}
+
+	return steps, nil
+}
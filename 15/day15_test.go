@@ -0,0 +1,58 @@
+package day15
+
+import (
+	"AoC_2023/lib/daytest"
+	"strings"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 1320, 145)
+}
+
+func TestHash(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"HASH", 52},
+		{"rn=1", 30},
+		{"cm-", 253},
+		{"rn", 0},
+		{"qp", 1},
+	}
+
+	for _, test := range tests {
+		if got := hash(test.s); got != test.want {
+			t.Errorf("hash(%q) = %d, want %d", test.s, got, test.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		line   int
+		column int
+	}{
+		{"focal length overflows", "rn=1,ab=99999999999999999999", 1, 9},
+		{"missing operation", "rn=1,cm", 1, 6},
+		{"empty", "", 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Solver{}.Parse(strings.NewReader(test.input))
+			daytest.ParseError(t, err, 15, test.line, test.column)
+		})
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
@@ -0,0 +1,195 @@
+package day16
+
+import (
+	"AoC_2023/lib"
+	"io"
+)
+
+type OpticalElement int
+
+const (
+	None OpticalElement = iota
+	LeftSlantMirror
+	RightSlantMirror
+	VerticalSplitter
+	HorizontalSplitter
+)
+
+// Every element's outgoing directions for each incoming one, worked out up
+// front so tracing a beam doesn't allocate a slice at every square.
+var outgoing = func() (table [5][4][]lib.Direction) {
+	for element := range table {
+		for _, in := range lib.Directions {
+			table[element][in] = OpticalElement(element).bounce(in)
+		}
+	}
+	return table
+}()
+
+// Next gives the directions a beam travelling in leaves the element in. The
+// slice is shared, so don't modify it.
+func (element OpticalElement) Next(in lib.Direction) []lib.Direction {
+	return outgoing[element][in]
+}
+
+func (element OpticalElement) bounce(in lib.Direction) []lib.Direction {
+	switch element {
+	case None:
+		return []lib.Direction{in}
+	case LeftSlantMirror:
+		if in.IsHorizontal() {
+			return []lib.Direction{in.Clockwise()}
+		} else {
+			return []lib.Direction{in.Anticlockwise()}
+		}
+	case RightSlantMirror:
+		if in.IsHorizontal() {
+			return []lib.Direction{in.Anticlockwise()}
+		} else {
+			return []lib.Direction{in.Clockwise()}
+		}
+	case HorizontalSplitter:
+		if in.IsHorizontal() {
+			return []lib.Direction{in}
+		} else {
+			return []lib.Direction{lib.Left, lib.Right}
+		}
+	case VerticalSplitter:
+		if in.IsHorizontal() {
+			return []lib.Direction{lib.Up, lib.Down}
+		} else {
+			return []lib.Direction{in}
+		}
+	}
+	return []lib.Direction{}
+}
+
+type Location struct {
+	lib.Point
+	travelDirection lib.Direction
+}
+
+func init() {
+	lib.Register(16, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Parse(r io.Reader) ([][]OpticalElement, error) {
+	return readInput(lib.NewScanner(16, r))
+}
+
+func (Solver) Part1(elements [][]OpticalElement) int {
+	return part1(elements)
+}
+
+func (Solver) Part2(elements [][]OpticalElement) int {
+	return part2(elements)
+}
+
+func part1(elements [][]OpticalElement) int {
+	start := Location{lib.Point{Row: 0, Col: 0}, lib.Right}
+	return newEnergizer(elements).energize(start)
+}
+
+func part2(elements [][]OpticalElement) int {
+	n, m := len(elements), len(elements[0])
+	energizer := newEnergizer(elements)
+	max_energized := 0
+
+	// TODO There should be away to reuse the number of
+	// squares energized by entering a particular square from a
+	// particular direction across runs, which would hugely reduce
+	// duplicated work. But since brute force is already pretty fast
+	// (under a second), I'm going to call this good for now.
+
+	for i := 0; i < n; i++ {
+		left_start := Location{lib.Point{Row: i, Col: 0}, lib.Right}
+		right_start := Location{lib.Point{Row: i, Col: m - 1}, lib.Left}
+
+		max_energized = max(
+			max_energized,
+			energizer.energize(left_start),
+			energizer.energize(right_start),
+		)
+	}
+
+	for j := 0; j < m; j++ {
+		top_start := Location{lib.Point{Row: 0, Col: j}, lib.Down}
+		bottom_start := Location{lib.Point{Row: n - 1, Col: j}, lib.Up}
+		max_energized = max(
+			max_energized,
+			energizer.energize(top_start),
+			energizer.energize(bottom_start),
+		)
+	}
+
+	return max_energized
+}
+
+// Traces beams through the contraption. It keeps its buffers between runs,
+// so part 2 can try every start without allocating.
+type energizer struct {
+	elements  [][]OpticalElement
+	visited   lib.Bitset // One bit for each square and travel direction
+	energized lib.BitGrid
+	beams     []Location
+}
+
+func newEnergizer(elements [][]OpticalElement) *energizer {
+	n, m := len(elements), len(elements[0])
+	return &energizer{
+		elements:  elements,
+		visited:   lib.NewBitset(n * m * len(lib.Directions)),
+		energized: lib.NewBitGrid(n, m),
+	}
+}
+
+func (self *energizer) energize(start Location) int {
+	self.visited.Reset()
+	self.energized.Reset()
+	self.beams = self.beams[:0]
+
+	self.visit(start)
+	for len(self.beams) > 0 {
+		location := lib.PopSlice(&self.beams)
+		for _, d := range self.elements[location.Row][location.Col].Next(location.travelDirection) {
+			if moved := location.Move(d, 1); self.energized.InBounds(moved) {
+				self.visit(Location{moved, d})
+			}
+		}
+	}
+
+	// A square can be crossed by beams going in several directions, but it's
+	// only energized once
+	return self.energized.Count()
+}
+
+func (self *energizer) visit(location Location) {
+	square := location.Row*self.energized.Cols() + location.Col
+	if self.visited.Add(square*len(lib.Directions) + int(location.travelDirection)) {
+		self.energized.Add(location.Point)
+		self.beams = append(self.beams, location)
+	}
+}
+
+var legend = lib.Legend[OpticalElement]{
+	'.':  None,
+	'/':  RightSlantMirror,
+	'\\': LeftSlantMirror,
+	'|':  VerticalSplitter,
+	'-':  HorizontalSplitter,
+}
+
+func readInput(scanner *lib.Scanner) ([][]OpticalElement, error) {
+	grid, err := lib.ReadGridLegend(scanner, legend)
+	if err != nil {
+		return nil, err
+	}
+
+	elements := make([][]OpticalElement, grid.Rows())
+	for i := range elements {
+		elements[i] = grid.Row(i)
+	}
+	return elements, nil
+}
@@ -0,0 +1,51 @@
+package day16
+
+import (
+	"AoC_2023/lib"
+	"AoC_2023/lib/daytest"
+	"strings"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 46, 51)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		line   int
+		column int
+	}{
+		{"empty row", "\n", 1, 0},
+		{"blank line between rows", "..|\n\n-..", 2, 0},
+		{"ragged row", "..|\n-.", 2, 0},
+		{"unknown element", "..|\n-x.", 2, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Solver{}.Parse(strings.NewReader(test.input))
+			daytest.ParseError(t, err, 16, test.line, test.column)
+		})
+	}
+}
+
+func TestEnergizeDoesNotAllocate(t *testing.T) {
+	energizer := newEnergizer(daytest.ParseFile(t, Solver{}, "example"))
+	start := Location{lib.Point{Row: 0, Col: 3}, lib.Down}
+	energizer.energize(start) // Lets the beam stack grow to size
+
+	if allocs := testing.AllocsPerRun(10, func() { energizer.energize(start) }); allocs != 0 {
+		t.Errorf("got %.0f allocations per run, want 0", allocs)
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
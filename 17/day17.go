@@ -0,0 +1,127 @@
+package day17
+
+import (
+	"AoC_2023/lib"
+	"io"
+)
+
+type State struct {
+	lib.Point
+	direction lib.Direction
+	steps     int
+}
+
+func init() {
+	lib.Register(17, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Parse(r io.Reader) ([][]int, error) {
+	return readInput(lib.NewScanner(17, r))
+}
+
+func (Solver) Part1(maze [][]int) int {
+	return part1(maze)
+}
+
+func (Solver) Part2(maze [][]int) int {
+	return part2(maze)
+}
+
+func part1(maze [][]int) int {
+	return astar(maze, 1, 3)
+}
+
+func part2(maze [][]int) int {
+	return astar(maze, 4, 10)
+}
+
+func astar(maze [][]int, minStep, maxStep int) int {
+	n, m := len(maze), len(maze[0])
+
+	// The search is done with each state's moves before asking for the next
+	// state's, so one buffer does for all of them
+	moves := make([]lib.Neighbor[State], 0, 3)
+	neighbors := func(state State) []lib.Neighbor[State] {
+		moves = moves[:0]
+		for _, next := range state.next(minStep, maxStep) {
+			if next.Row < 0 || next.Row == n || next.Col < 0 || next.Col == m {
+				continue
+			}
+			moves = append(moves, lib.Neighbor[State]{State: next, Cost: maze[next.Row][next.Col]})
+		}
+		return moves
+	}
+
+	// The crucible can only stop once it's travelled far enough in a straight line
+	goal := func(state State) bool {
+		return state.Row == n-1 && state.Col == m-1 && state.steps >= minStep
+	}
+
+	// Every remaining block costs at least 1, so the manhattan distance to
+	// the corner never overestimates
+	corner := lib.Point{Row: n - 1, Col: m - 1}
+	heuristic := func(state State) int {
+		return lib.Manhattan(state.Point, corner)
+	}
+
+	result := lib.AStarCost(State{}, neighbors, goal, heuristic)
+	if !result.Found {
+		return -1
+	}
+	return result.Cost
+}
+
+// The states a crucible can move to in one block, ignoring the maze's edges.
+func (state State) next(minStep, maxStep int) []State {
+	nextStates := make([]State, 0, 3)
+
+	// Crucibles can't reverse, so they either turn or keep going. Before the
+	// first move, the crucible can head off in any direction.
+	turns := []lib.Direction{state.direction.Anticlockwise(), state.direction.Clockwise(), state.direction}
+	if state.steps == 0 {
+		turns = lib.Directions[:]
+	}
+
+	for _, nextDirection := range turns {
+		if nextDirection == state.direction && state.steps == maxStep {
+			continue
+		}
+
+		if state.steps > 0 && state.steps < minStep && nextDirection != state.direction {
+			continue
+		}
+
+		next := State{state.Move(nextDirection, 1), nextDirection, 1}
+		if nextDirection == state.direction {
+			next.steps = state.steps + 1
+		}
+
+		nextStates = append(nextStates, next)
+	}
+
+	return nextStates
+}
+
+// Every block loses between 1 and 9 heat, so a 0 is as malformed as a letter
+var legend = func() lib.Legend[int] {
+	legend := make(lib.Legend[int])
+	for heatLoss := 1; heatLoss <= 9; heatLoss++ {
+		legend[rune('0'+heatLoss)] = heatLoss
+	}
+	return legend
+}()
+
+func readInput(scanner *lib.Scanner) ([][]int, error) {
+	grid, err := lib.ReadGridLegend(scanner, legend)
+	if err != nil {
+		return nil, err
+	}
+
+	maze := make([][]int, grid.Rows())
+	for i := range maze {
+		maze[i] = grid.Row(i)
+	}
+	return maze, nil
+}
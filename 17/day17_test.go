@@ -0,0 +1,139 @@
+package day17
+
+import (
+	"AoC_2023/lib"
+	"AoC_2023/lib/daytest"
+	"strings"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 102, 94)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		line   int
+		column int
+	}{
+		{"empty row", "\n", 1, 0},
+		{"blank line between rows", "123\n\n456", 2, 0},
+		{"ragged row", "123\n45", 2, 0},
+		{"zero heat loss", "123\n405", 2, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Solver{}.Parse(strings.NewReader(test.input))
+			daytest.ParseError(t, err, 17, test.line, test.column)
+		})
+	}
+}
+
+func TestUltraCrucibleMustStopGradually(t *testing.T) {
+	maze := daytest.ParseString(t, Solver{}, `111111111111
+999999999991
+999999999991
+999999999991
+999999999991`)
+
+	if got := part2(maze); got != 71 {
+		t.Errorf("got %d, want 71", got)
+	}
+}
+
+func TestMatchesLazyDeletion(t *testing.T) {
+	maze := generateMaze(40)
+
+	for _, steps := range [][2]int{{1, 3}, {4, 10}} {
+		if got, want := astar(maze, steps[0], steps[1]), lazyAstar(maze, steps[0], steps[1]); got != want {
+			t.Errorf("steps %v: got %d, want %d", steps, got, want)
+		}
+	}
+}
+
+// Compares decrease-key against pushing duplicate states and skipping the
+// stale ones when they're popped, which is how astar used to work.
+func BenchmarkAstar(b *testing.B) {
+	maze := generateMaze(141)
+
+	searches := []struct {
+		name   string
+		search func([][]int, int, int) int
+	}{
+		{"decrease key", astar},
+		{"lazy deletion", lazyAstar},
+	}
+
+	for _, search := range searches {
+		b.Run(search.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				search.search(maze, 4, 10)
+			}
+		})
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
+
+func lazyAstar(maze [][]int, minStep, maxStep int) int {
+	type step struct {
+		State
+		loss      int
+		estimated int
+	}
+
+	n, m := len(maze), len(maze[0])
+	losses := make(map[State]int)
+	pq := lib.NewHeap(func(a, b step) bool { return a.estimated < b.estimated })
+	pq.Push(step{estimated: n + m - 2})
+
+	for pq.Len() > 0 {
+		node := pq.Pop()
+
+		if loss, found := losses[node.State]; found && loss <= node.loss {
+			continue
+		}
+		losses[node.State] = node.loss
+
+		if node.Row == n-1 && node.Col == m-1 && node.steps >= minStep {
+			return node.loss
+		}
+
+		for _, next := range node.next(minStep, maxStep) {
+			if next.Row < 0 || next.Row == n || next.Col < 0 || next.Col == m {
+				continue
+			}
+
+			loss := node.loss + maze[next.Row][next.Col]
+			pq.Push(step{next, loss, loss + (n - 1 - next.Row) + (m - 1 - next.Col)})
+		}
+	}
+
+	return -1
+}
+
+// A deterministic maze the size of a real puzzle input.
+func generateMaze(size int) [][]int {
+	maze := make([][]int, size)
+	seed := uint32(17)
+
+	for i := range maze {
+		maze[i] = make([]int, size)
+		for j := range maze[i] {
+			seed = seed*1664525 + 1013904223
+			maze[i][j] = int(seed>>28)%9 + 1
+		}
+	}
+
+	return maze
+}
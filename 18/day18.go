@@ -0,0 +1,101 @@
+package day18
+
+import (
+	"AoC_2023/lib"
+	"AoC_2023/lib/parse"
+	"io"
+	"regexp"
+)
+
+// The last hex digit of a color is the direction the edge really goes
+var hexDirections = [4]lib.Direction{lib.Right, lib.Down, lib.Left, lib.Up}
+
+type Color uint
+
+type Edge struct {
+	direction lib.Direction
+	length    int
+	color     Color
+}
+
+func (e Edge) fixEdge() Edge {
+	distance, direction := e.color&0xfffffff0, e.color&0x0000000f
+	return Edge{
+		direction: hexDirections[direction],
+		length:    int(distance) >> 4,
+	}
+}
+
+func init() {
+	lib.Register(18, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Parse(r io.Reader) ([]Edge, error) {
+	return readInput(lib.NewScanner(18, r))
+}
+
+func (Solver) Part1(edges []Edge) int {
+	return part1(edges)
+}
+
+func (Solver) Part2(edges []Edge) int {
+	return part2(edges)
+}
+
+func part1(edges []Edge) int {
+	return greens(edges)
+}
+
+func part2(errantEdges []Edge) int {
+	fixedEdges := make([]Edge, len(errantEdges))
+	for i, e := range errantEdges {
+		fixedEdges[i] = e.fixEdge()
+	}
+
+	return greens(fixedEdges)
+}
+
+func greens(edges []Edge) int {
+	area := 1
+	height := 0
+
+	for _, e := range edges {
+		if e.direction == lib.Up {
+			area += e.length
+			height += e.length
+		} else if e.direction == lib.Down {
+			height -= e.length
+		} else if e.direction == lib.Right {
+			area += (height + 1) * e.length
+		} else {
+			area -= height * e.length
+		}
+	}
+
+	return area
+}
+
+var edgePattern = regexp.MustCompile(`^(?P<direction>[URDL]) (?P<length>[0-9]+) \(#(?P<color>[0-9a-f]{5}[0-3])\)$`)
+
+func readInput(scanner *lib.Scanner) ([]Edge, error) {
+	edges := make([]Edge, 0)
+
+	for scanner.Scan() {
+		var line struct {
+			Direction string
+			Length    int
+			Color     Color `parse:",hex"`
+		}
+		if err := parse.Bind(scanner, edgePattern, &line); err != nil {
+			return nil, err
+		}
+
+		// The pattern only lets through directions that parse
+		direction, _ := lib.ParseDirection(line.Direction)
+		edges = append(edges, Edge{direction, line.Length, line.Color})
+	}
+
+	return edges, scanner.Err()
+}
@@ -0,0 +1,37 @@
+package day18
+
+import (
+	"AoC_2023/lib"
+	"AoC_2023/lib/daytest"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	daytest.Example(t, Solver{}, "example", 62, 952408144115)
+}
+
+func TestFixEdge(t *testing.T) {
+	tests := []struct {
+		line string
+		want Edge
+	}{
+		{"R 6 (#70c710)", Edge{direction: lib.Right, length: 461937}},
+		{"D 5 (#0dc571)", Edge{direction: lib.Down, length: 56407}},
+		{"L 2 (#8ceee2)", Edge{direction: lib.Left, length: 577262}},
+		{"U 2 (#caa173)", Edge{direction: lib.Up, length: 829975}},
+	}
+
+	for _, test := range tests {
+		if got := daytest.ParseString(t, Solver{}, test.line)[0].fixEdge(); got != test.want {
+			t.Errorf("%s: got %+v, want %+v", test.line, got, test.want)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	daytest.BenchmarkPart1(b, Solver{}, "example")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	daytest.BenchmarkPart2(b, Solver{}, "example")
+}
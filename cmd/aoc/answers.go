@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	answersPath        = "answers.txt"
+	exampleAnswersPath = "answers.example.txt"
+)
+
+type answerKey struct {
+	day  int
+	part int
+}
+
+// Known-correct answers. On disk it's one answer per line written as
+// "<day> <part> <answer>", with blank lines and # comments ignored.
+type answerStore map[answerKey]int
+
+func defaultAnswersPath(source inputSource) string {
+	if source.example {
+		return exampleAnswersPath
+	}
+	return answersPath
+}
+
+// A missing file is just an empty store; every answer will show up as missing.
+// Two lines answering the same part are an error, since only one can be right.
+func loadAnswers(path string) (answerStore, error) {
+	store := make(answerStore)
+	lineNums := make(map[answerKey]int)
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("%s:%d: want \"<day> <part> <answer>\", got %q", path, lineNum, scanner.Text())
+		}
+
+		values := [3]int{}
+		for i, field := range fields {
+			if values[i], err = strconv.Atoi(field); err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, lineNum, err)
+			}
+		}
+
+		key := answerKey{day: values[0], part: values[1]}
+		if earlier, ok := lineNums[key]; ok {
+			return nil, fmt.Errorf("%s:%d: day %d part %d is already answered on line %d", path, lineNum, key.day, key.part, earlier)
+		}
+		lineNums[key] = lineNum
+		store[key] = values[2]
+	}
+
+	return store, scanner.Err()
+}
+
+// Records an accepted answer, replacing any existing line for the same day
+// and part. Everything else in the file, comments included, is left alone.
+func recordAnswer(path string, day, part, answer int) error {
+	raw, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	entry := fmt.Sprintf("%d %d %d", day, part, answer)
+	lines := strings.Split(strings.TrimSuffix(string(raw), "\n"), "\n")
+	if len(raw) == 0 {
+		lines = nil
+	}
+
+	replaced := false
+	for i, line := range lines {
+		content, _, _ := strings.Cut(line, "#")
+		fields := strings.Fields(content)
+		if len(fields) == 3 && fields[0] == fmt.Sprint(day) && fields[1] == fmt.Sprint(part) {
+			lines[i] = entry
+			replaced = true
+		}
+	}
+
+	if !replaced {
+		lines = append(lines, entry)
+	}
+
+	return os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644)
+}
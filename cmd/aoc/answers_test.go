@@ -0,0 +1,96 @@
+package main
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeAnswers(t *testing.T, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "answers.txt")
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadAnswers(t *testing.T) {
+	path := writeAnswers(t, "# day part answer\n1 1 12\n\n1 2 281 # the second try\n")
+
+	store, err := loadAnswers(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := answerStore{{1, 1}: 12, {1, 2}: 281}
+	if !maps.Equal(store, want) {
+		t.Errorf("got %v, want %v", store, want)
+	}
+}
+
+func TestLoadAnswersErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		want     string
+	}{
+		{"too few fields", "1 1 12\n1 2\n", "answers.txt:2: want"},
+		{"too many fields", "1 1 12 13\n", "answers.txt:1: want"},
+		{"not a number", "1 1 12\n\n1 2 lots\n", "answers.txt:3: strconv.Atoi"},
+		{"duplicate", "1 1 12\n1 2 281\n1 1 13\n", "answers.txt:3: day 1 part 1 is already answered on line 1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := loadAnswers(writeAnswers(t, test.contents))
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("got error %v, want one saying %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestRecordAnswer(t *testing.T) {
+	path := writeAnswers(t, "# Accepted answers\n1 1 12\n2 1 8\n")
+
+	if err := recordAnswer(path, 1, 2, 281); err != nil {
+		t.Fatal(err)
+	}
+	if err := recordAnswer(path, 2, 1, 9); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := loadAnswers(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := answerStore{{1, 1}: 12, {1, 2}: 281, {2, 1}: 9}
+	if !maps.Equal(store, want) {
+		t.Errorf("got %v, want %v", store, want)
+	}
+
+	if raw, _ := os.ReadFile(path); !strings.HasPrefix(string(raw), "# Accepted answers\n") {
+		t.Errorf("the comment was lost:\n%s", raw)
+	}
+}
+
+func TestRecordAnswerCreatesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.txt")
+
+	if err := recordAnswer(path, 3, 1, 4361); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := loadAnswers(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (answerStore{{3, 1}: 4361}); !maps.Equal(store, want) {
+		t.Errorf("got %v, want %v", store, want)
+	}
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"AoC_2023/lib"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"runtime"
+	"slices"
+	"time"
+)
+
+var phases = [3]string{"parse", "part1", "part2"}
+
+type timing struct {
+	Mean        time.Duration `json:"mean_ns"`
+	P50         time.Duration `json:"p50_ns"`
+	P99         time.Duration `json:"p99_ns"`
+	AllocsPerOp uint64        `json:"allocs_per_op"`
+	BytesPerOp  uint64        `json:"bytes_per_op"`
+}
+
+// Timings for every benchmarked day, keyed by day number then phase. This is
+// also the format of the baseline file.
+type benchResults map[int]map[string]timing
+
+// Keeps the compiler from getting clever with answers nobody reads
+var sink any
+
+func bench(args []string) error {
+	var source inputSource
+	var iterations int
+	var savePath, baselinePath string
+	var threshold float64
+	flags := flag.NewFlagSet("bench", flag.ExitOnError)
+	flags.Usage = exitWithUsage
+	source.register(flags)
+	flags.IntVar(&iterations, "n", 10, "")
+	flags.StringVar(&savePath, "save", "", "")
+	flags.StringVar(&baselinePath, "baseline", "", "")
+	flags.Float64Var(&threshold, "threshold", 10, "")
+	flags.Parse(args)
+
+	if flags.NArg() > 1 || iterations < 1 {
+		exitWithUsage()
+	}
+
+	days := lib.Days()
+	if flags.NArg() == 1 {
+		var err error
+		if days, err = selectDays(flags.Arg(0)); err != nil {
+			return err
+		}
+	}
+
+	if err := source.validate(len(days)); err != nil {
+		return err
+	}
+
+	var baseline benchResults
+	if baselinePath != "" {
+		var err error
+		if baseline, err = loadBenchResults(baselinePath); err != nil {
+			return err
+		}
+	}
+
+	results := make(benchResults)
+	regressions := 0
+
+	fmt.Printf("%-4s %-6s %12s %12s %12s %10s %12s\n", "day", "phase", "mean", "p50", "p99", "allocs/op", "B/op")
+	for _, day := range days {
+		raw, err := readAll(day, source)
+		if errors.Is(err, fs.ErrNotExist) {
+			fmt.Printf("%02d   skipped, no input\n", day.Number)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if results[day.Number], err = benchDay(day, raw, iterations); err != nil {
+			return err
+		}
+
+		for _, phase := range phases {
+			t := results[day.Number][phase]
+			fmt.Printf("%02d   %-6s %12v %12v %12v %10d %12d",
+				day.Number, phase, t.Mean, t.P50, t.P99, t.AllocsPerOp, t.BytesPerOp)
+
+			if base, ok := baseline[day.Number][phase]; ok && base.P50 > 0 {
+				change := 100 * float64(t.P50-base.P50) / float64(base.P50)
+				fmt.Printf("  %+7.1f%%", change)
+				if change > threshold {
+					fmt.Print("  REGRESSED")
+					regressions++
+				}
+			}
+			fmt.Println()
+		}
+	}
+
+	if savePath != "" {
+		if err := saveBenchResults(savePath, results); err != nil {
+			return err
+		}
+	}
+
+	if regressions > 0 {
+		return fmt.Errorf("%d phases regressed more than %.1f%% against %s", regressions, threshold, baselinePath)
+	}
+
+	return nil
+}
+
+func benchDay(day lib.Day, raw []byte, iterations int) (map[string]timing, error) {
+	input, err := day.Parse(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]timing{
+		"parse": measure(iterations, func() { sink, _ = day.Parse(bytes.NewReader(raw)) }),
+		"part1": measure(iterations, func() { sink = day.Part1(input) }),
+		"part2": measure(iterations, func() { sink = day.Part2(input) }),
+	}, nil
+}
+
+func measure(iterations int, fn func()) timing {
+	durations := make([]time.Duration, iterations)
+	var total time.Duration
+	var before, after runtime.MemStats
+
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	for i := range durations {
+		start := time.Now()
+		fn()
+		durations[i] = time.Since(start)
+		total += durations[i]
+	}
+	runtime.ReadMemStats(&after)
+
+	slices.Sort(durations)
+	n := uint64(iterations)
+	return timing{
+		Mean:        total / time.Duration(iterations),
+		P50:         percentile(durations, 50),
+		P99:         percentile(durations, 99),
+		AllocsPerOp: (after.Mallocs - before.Mallocs) / n,
+		BytesPerOp:  (after.TotalAlloc - before.TotalAlloc) / n,
+	}
+}
+
+// Nearest-rank percentile of an already sorted slice: the smallest value
+// that at least p percent of the values are no bigger than.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100 // ceil(p*n/100)
+	return sorted[max(rank, 1)-1]
+}
+
+func readAll(day lib.Day, source inputSource) ([]byte, error) {
+	reader, err := source.open(day.Number)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
+
+func loadBenchResults(path string) (benchResults, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var results benchResults
+	if err := json.Unmarshal(raw, &results); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return results, nil
+}
+
+func saveBenchResults(path string, results benchResults) error {
+	raw, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(raw, '\n'), 0644)
+}
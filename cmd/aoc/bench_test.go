@@ -0,0 +1,35 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPercentile(t *testing.T) {
+	sorted := make([]time.Duration, 10)
+	for i := range sorted {
+		sorted[i] = time.Duration(i + 1)
+	}
+
+	tests := []struct {
+		durations []time.Duration
+		p         int
+		want      time.Duration
+	}{
+		{sorted, 50, 5},
+		{sorted, 99, 10},
+		{sorted, 90, 9},
+		{sorted, 91, 10},
+		{sorted, 0, 1},
+		{sorted, 100, 10},
+		{sorted[:1], 50, 1},
+		{sorted[:4], 50, 2},
+		{sorted[:4], 99, 4},
+	}
+
+	for _, test := range tests {
+		if got := percentile(test.durations, test.p); got != test.want {
+			t.Errorf("p%d of %v: got %v, want %v", test.p, test.durations, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	year           = 2023
+	defaultBaseURL = "https://adventofcode.com"
+	userAgent      = "github.com/mdd36/AoC-2023/cmd/aoc"
+)
+
+// Talks to the puzzle site. baseURL is swappable so that everything can be
+// pointed at a local stand-in instead of the real server.
+type client struct {
+	baseURL string
+	session string
+	http    *http.Client
+}
+
+func newClient(baseURL, session string) *client {
+	return &client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		session: session,
+		http:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Where the site lives, from -base-url, then $AOC_BASE_URL, then the real thing
+func resolveBaseURL(flagValue string) string {
+	if flagValue != "" {
+		return flagValue
+	}
+	if env := os.Getenv("AOC_BASE_URL"); env != "" {
+		return env
+	}
+	return defaultBaseURL
+}
+
+// The session cookie comes from $AOC_SESSION, falling back to the
+// aoc/session file in the user's config directory.
+func loadSession() (string, error) {
+	if session := strings.TrimSpace(os.Getenv("AOC_SESSION")); session != "" {
+		return session, nil
+	}
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(configDir, "aoc", "session")
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("no session token, set $AOC_SESSION or write it to %s: %w", path, err)
+	}
+
+	session := strings.TrimSpace(string(raw))
+	if session == "" {
+		return "", fmt.Errorf("session file %s is empty", path)
+	}
+	return session, nil
+}
+
+func (self *client) fetchInput(day int) ([]byte, error) {
+	request, err := self.newRequest(http.MethodGet, fmt.Sprintf("/%d/day/%d/input", year, day), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := self.http.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch code := response.StatusCode; {
+	case code == http.StatusOK:
+		return body, nil
+	case code == http.StatusNotFound:
+		return nil, fmt.Errorf("day %02d isn't available yet", day)
+	case code == http.StatusBadRequest || code == http.StatusUnauthorized:
+		// The site answers a missing or expired session with one of these
+		return nil, errors.New("the puzzle server rejected the session token, it may have expired")
+	case code >= http.StatusInternalServerError:
+		return nil, fmt.Errorf("the puzzle server failed to serve day %02d input, try again later: %s", day, response.Status)
+	default:
+		return nil, fmt.Errorf("fetching day %02d input: %s", day, response.Status)
+	}
+}
+
+type verdict int
+
+const (
+	unknownVerdict verdict = iota
+	correct
+	wrong
+	tooHigh
+	tooLow
+	rateLimited
+	alreadySolved
+)
+
+func (v verdict) String() string {
+	switch v {
+	case correct:
+		return "correct"
+	case wrong:
+		return "wrong"
+	case tooHigh:
+		return "too-high"
+	case tooLow:
+		return "too-low"
+	case rateLimited:
+		return "rate-limited"
+	case alreadySolved:
+		return "already-solved"
+	}
+	return "unknown"
+}
+
+func parseVerdictName(name string) verdict {
+	for v := correct; v <= alreadySolved; v++ {
+		if v.String() == name {
+			return v
+		}
+	}
+	return unknownVerdict
+}
+
+// What the site made of a submission, and how long it wants us to hold off
+// before the next one.
+type submissionResult struct {
+	verdict verdict
+	wait    time.Duration
+}
+
+func (self *client) submitAnswer(day, part, answer int) (submissionResult, error) {
+	form := url.Values{}
+	form.Set("level", fmt.Sprint(part))
+	form.Set("answer", fmt.Sprint(answer))
+
+	request, err := self.newRequest(http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return submissionResult{}, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := self.http.Do(request)
+	if err != nil {
+		return submissionResult{}, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return submissionResult{}, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return submissionResult{}, fmt.Errorf("submitting day %02d part %d: %s", day, part, response.Status)
+	}
+
+	result := parseSubmissionPage(string(body))
+	if result.verdict == unknownVerdict {
+		return result, errors.New("couldn't make sense of the response page")
+	}
+	return result, nil
+}
+
+var (
+	rateLimitWaitPattern = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	retryWaitPattern     = regexp.MustCompile(`(?i)wait (one|\d+) minutes? before trying again`)
+)
+
+// The response is an HTML page written for people, so this goes looking for
+// the phrases the site uses rather than trying to understand the markup.
+func parseSubmissionPage(page string) submissionResult {
+	result := submissionResult{}
+
+	switch {
+	case strings.Contains(page, "That's the right answer"):
+		result.verdict = correct
+	case strings.Contains(page, "You gave an answer too recently"):
+		result.verdict = rateLimited
+	case strings.Contains(page, "You don't seem to be solving the right level"):
+		result.verdict = alreadySolved
+	case strings.Contains(page, "That's not the right answer"):
+		result.verdict = wrong
+		if strings.Contains(page, "your answer is too high") {
+			result.verdict = tooHigh
+		} else if strings.Contains(page, "your answer is too low") {
+			result.verdict = tooLow
+		}
+	}
+
+	if match := rateLimitWaitPattern.FindStringSubmatch(page); match != nil {
+		minutes, _ := strconv.Atoi(match[1]) // An empty match means no minutes at all
+		seconds, _ := strconv.Atoi(match[2])
+		result.wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if match := retryWaitPattern.FindStringSubmatch(page); match != nil {
+		minutes := 1
+		if match[1] != "one" {
+			minutes, _ = strconv.Atoi(match[1])
+		}
+		result.wait = time.Duration(minutes) * time.Minute
+	}
+
+	return result
+}
+
+func (self *client) newRequest(method, path string, body io.Reader) (*http.Request, error) {
+	request, err := http.NewRequest(method, self.baseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("User-Agent", userAgent)
+	request.AddCookie(&http.Cookie{Name: "session", Value: url.QueryEscape(self.session)})
+	return request, nil
+}
@@ -0,0 +1,23 @@
+package main
+
+// Importing a day registers it with the runner.
+import (
+	_ "AoC_2023/01"
+	_ "AoC_2023/02"
+	_ "AoC_2023/03"
+	_ "AoC_2023/04"
+	_ "AoC_2023/05"
+	_ "AoC_2023/06"
+	_ "AoC_2023/07"
+	_ "AoC_2023/08"
+	_ "AoC_2023/09"
+	_ "AoC_2023/10"
+	_ "AoC_2023/11"
+	_ "AoC_2023/12"
+	_ "AoC_2023/13"
+	_ "AoC_2023/14"
+	_ "AoC_2023/15"
+	_ "AoC_2023/16"
+	_ "AoC_2023/17"
+	_ "AoC_2023/18"
+)
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"AoC_2023/lib"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+func fetch(args []string) error {
+	var baseURL string
+	flags := flag.NewFlagSet("fetch", flag.ExitOnError)
+	flags.Usage = exitWithUsage
+	flags.StringVar(&baseURL, "base-url", "", "")
+	flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		exitWithUsage()
+	}
+
+	// Unlike the other commands, any day can be fetched, registered or not,
+	// since the input is usually wanted before the solution exists
+	var numbers []int
+	if flags.Arg(0) == "all" {
+		for _, day := range lib.Days() {
+			numbers = append(numbers, day.Number)
+		}
+	} else {
+		number, err := parseDayNumber(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		numbers = append(numbers, number)
+	}
+
+	var site *client
+	for _, number := range numbers {
+		path, err := cachedInputPath(number)
+		if err != nil {
+			return err
+		}
+
+		if fileExists(path) {
+			fmt.Printf("Day %02d already cached at %s\n", number, path)
+			continue
+		}
+
+		// Only go looking for a session once there's something to download
+		if site == nil {
+			session, err := loadSession()
+			if err != nil {
+				return err
+			}
+			site = newClient(resolveBaseURL(baseURL), session)
+		}
+
+		input, err := site.fetchInput(number)
+		if err != nil {
+			return err
+		}
+
+		if err := writeFileAtomic(path, input); err != nil {
+			return err
+		}
+		fmt.Printf("Day %02d saved to %s\n", number, path)
+	}
+
+	return nil
+}
+
+// Inputs are cached per user, under $AOC_CACHE_DIR if it's set or the
+// user's cache directory otherwise.
+func cacheDir() (string, error) {
+	if dir := os.Getenv("AOC_CACHE_DIR"); dir != "" {
+		return dir, nil
+	}
+
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "aoc", fmt.Sprint(year)), nil
+}
+
+func cachedInputPath(day int) (string, error) {
+	dir, err := cacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, dayDir(day), "input"), nil
+}
+
+// Writes through a temporary file so an interrupted download never leaves a
+// half-written input behind that would then count as cached.
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".download-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return !errors.Is(err, fs.ErrNotExist)
+}
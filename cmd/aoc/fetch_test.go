@@ -0,0 +1,100 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Points fetch at a temporary cache and a stand-in for the puzzle site.
+func fakeSite(t *testing.T, handler http.HandlerFunc) string {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cache := t.TempDir()
+	t.Setenv("AOC_CACHE_DIR", cache)
+	t.Setenv("AOC_BASE_URL", server.URL)
+	t.Setenv("AOC_SESSION", "secret")
+	return cache
+}
+
+func TestFetchSendsSessionAndUserAgent(t *testing.T) {
+	cache := fakeSite(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2023/day/5/input" {
+			t.Errorf("requested %s", r.URL.Path)
+		}
+		if got := r.Header.Get("User-Agent"); got != userAgent {
+			t.Errorf("got User-Agent %q, want %q", got, userAgent)
+		}
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			t.Errorf("got session cookie %v, %v", cookie, err)
+		}
+		w.Write([]byte("seeds: 1 2\n"))
+	})
+
+	if err := fetch([]string{"5"}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(cache, "05", "input"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "seeds: 1 2\n" {
+		t.Errorf("cached %q", got)
+	}
+}
+
+func TestFetchUsesCache(t *testing.T) {
+	cache := fakeSite(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("downloaded %s although it was cached", r.URL.Path)
+	})
+
+	path := filepath.Join(cache, "05", "input")
+	if err := writeFileAtomic(path, []byte("cached\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := fetch([]string{"5"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, _ := os.ReadFile(path); string(got) != "cached\n" {
+		t.Errorf("the cached input changed to %q", got)
+	}
+}
+
+func TestFetchInputErrors(t *testing.T) {
+	tests := []struct {
+		status int
+		want   string
+	}{
+		{http.StatusNotFound, "isn't available yet"},
+		{http.StatusBadRequest, "rejected the session token"},
+		{http.StatusUnauthorized, "rejected the session token"},
+		{http.StatusInternalServerError, "failed to serve"},
+		{http.StatusServiceUnavailable, "failed to serve"},
+		{http.StatusTeapot, "418"},
+	}
+
+	for _, test := range tests {
+		t.Run(http.StatusText(test.status), func(t *testing.T) {
+			cache := fakeSite(t, func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "nope", test.status)
+			})
+
+			err := fetch([]string{"5"})
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("got error %v, want one saying %q", err, test.want)
+			}
+			if fileExists(filepath.Join(cache, "05", "input")) {
+				t.Error("the error page was cached as input")
+			}
+		})
+	}
+}
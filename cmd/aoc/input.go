@@ -0,0 +1,76 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Where a day's puzzle input comes from. The zero value reads NN/input
+// relative to the working directory, which is the repo root for `go run`,
+// and falls back to the input cached by `aoc fetch`.
+type inputSource struct {
+	path    string // Explicit file to read, or "-" for stdin
+	example bool   // Read the day's checked-in example instead of its input
+}
+
+func (source *inputSource) register(flags *flag.FlagSet) {
+	flags.StringVar(&source.path, "input", "", "")
+	flags.BoolVar(&source.example, "example", false, "")
+}
+
+func (source inputSource) validate(numDays int) error {
+	if source.path != "" && source.example {
+		return errors.New("-input and -example can't be used together")
+	}
+
+	if source.path != "" && numDays > 1 {
+		return errors.New("-input only makes sense for a single day")
+	}
+
+	return nil
+}
+
+func (source inputSource) open(day int) (io.ReadCloser, error) {
+	if source.path == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+
+	path := source.path
+	if path == "" && source.example {
+		path = filepath.Join(dayDir(day), "example")
+	}
+	if path == "" {
+		path = defaultInputPath(day)
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) && source.path == "" && !source.example {
+		return nil, fmt.Errorf("no input for day %02d, run `aoc fetch %d` or pass -input or -example: %w", day, day, err)
+	}
+
+	return file, err
+}
+
+// A NN/input in the repo wins over the cache, so a hand-edited input can be
+// dropped in without touching the downloaded one.
+func defaultInputPath(day int) string {
+	local := filepath.Join(dayDir(day), "input")
+	if fileExists(local) {
+		return local
+	}
+
+	if cached, err := cachedInputPath(day); err == nil && fileExists(cached) {
+		return cached
+	}
+
+	return local
+}
+
+func dayDir(day int) string {
+	return fmt.Sprintf("%02d", day)
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"AoC_2023/lib"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+const usage = `Usage:
+  aoc run [flags] <day|all>      Solve both parts of a day, or every registered day
+  aoc verify [flags] [day|all]   Check answers against the answers file, all days by default
+  aoc bench [flags] [day|all]    Time parse, part 1 and part 2 separately, all days by default
+  aoc fetch [flags] <day|all>    Download and cache puzzle input
+  aoc submit [flags] <day> <part>
+                                 Solve one part and submit the answer
+  aoc new <day>                  Generate and register the package for a new day
+  aoc list                       List the registered days
+
+Flags for run, verify and bench:
+  -input <path>      Read the puzzle input from path, or from stdin if path is -
+  -example           Use the day's checked-in example instead of the real input
+
+Puzzle input is read from NN/input if it exists, otherwise from the cache
+that fetch downloads into.
+
+Flags for verify:
+  -answers <path>    Answers to check against (default answers.txt, or
+                     answers.example.txt with -example)
+
+Flags for bench:
+  -n <count>         Iterations of each phase (default 10)
+  -save <path>       Write the results as a baseline file
+  -baseline <path>   Compare against a saved baseline
+  -threshold <pct>   Median slowdown against the baseline that counts as a
+                     regression (default 10)
+
+Flags for fetch and submit:
+  -base-url <url>    Puzzle server to talk to (default $AOC_BASE_URL, or
+                     https://adventofcode.com)
+
+Flags for submit:
+  -input <path>      Solve from path instead of the day's input
+  -answers <path>    Where accepted answers are recorded (default answers.txt)
+
+Submit waits out any throttling the site asks for, and refuses to send an
+answer that an earlier submission already showed to be wrong.
+
+Environment:
+  AOC_SESSION        Session cookie for the puzzle server. Falls back to the
+                     aoc/session file in the user config directory
+  AOC_CACHE_DIR      Where fetched inputs are cached (default aoc/2023 in the
+                     user cache directory)`
+
+func main() {
+	if len(os.Args) < 2 {
+		exitWithUsage()
+	}
+
+	var err error
+	switch command, args := os.Args[1], os.Args[2:]; command {
+	case "run":
+		err = run(args)
+	case "verify":
+		err = verify(args)
+	case "bench":
+		err = bench(args)
+	case "fetch":
+		err = fetch(args)
+	case "submit":
+		err = submit(args)
+	case "new":
+		err = newDay(args)
+	case "list":
+		list()
+	default:
+		exitWithUsage()
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "aoc:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	var source inputSource
+	flags := flag.NewFlagSet("run", flag.ExitOnError)
+	flags.Usage = exitWithUsage
+	source.register(flags)
+	flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		exitWithUsage()
+	}
+
+	days, err := selectDays(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	if err := source.validate(len(days)); err != nil {
+		return err
+	}
+
+	for _, day := range days {
+		answers, err := solve(day, source)
+		if err != nil {
+			return err
+		}
+
+		for i, answer := range answers {
+			fmt.Printf("Day %02d part %d: %d\n", day.Number, i+1, answer)
+		}
+	}
+
+	return nil
+}
+
+func list() {
+	for _, day := range lib.Days() {
+		fmt.Printf("%02d\n", day.Number)
+	}
+}
+
+func solve(day lib.Day, source inputSource) ([2]int, error) {
+	input, err := parse(day, source)
+	if err != nil {
+		return [2]int{}, err
+	}
+
+	var answers [2]int
+	for i, part := range []func(any) (int, error){day.CheckedPart1, day.CheckedPart2} {
+		if answers[i], err = part(input); err != nil {
+			return [2]int{}, fmt.Errorf("day %02d part %d: %w", day.Number, i+1, err)
+		}
+	}
+	return answers, nil
+}
+
+func parse(day lib.Day, source inputSource) (any, error) {
+	reader, err := source.open(day.Number)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return day.Parse(reader)
+}
+
+// Resolves a day argument like "7", "07" or "all" to the registered days
+func selectDays(arg string) ([]lib.Day, error) {
+	if arg == "all" {
+		return lib.Days(), nil
+	}
+
+	number, err := parseDayNumber(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	day, ok := lib.Lookup(number)
+	if !ok {
+		return nil, fmt.Errorf("day %02d is not registered", number)
+	}
+
+	return []lib.Day{day}, nil
+}
+
+func parseDayNumber(arg string) (int, error) {
+	number, err := strconv.Atoi(arg)
+	if err != nil || number < 1 || number > 25 {
+		return 0, fmt.Errorf("%q is not a day number", arg)
+	}
+	return number, nil
+}
+
+func exitWithUsage() {
+	fmt.Fprintln(os.Stderr, usage)
+	os.Exit(2)
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates
+var templates embed.FS
+
+const daysFile = "cmd/aoc/days.go"
+
+// Generated files, keyed by template name
+var scaffold = map[string]string{
+	"day.go.tmpl":      "day%s.go",
+	"day_test.go.tmpl": "day%s_test.go",
+}
+
+// Generates the package for a new day from the templates and registers it
+// with the runner. Paths are relative to the repo root, so that's where this
+// has to run from.
+func newDay(args []string) error {
+	if len(args) != 1 {
+		exitWithUsage()
+	}
+
+	number, err := parseDayNumber(args[0])
+	if err != nil {
+		return err
+	}
+
+	if !fileExists("go.mod") || !fileExists(daysFile) {
+		return errors.New("aoc new has to be run from the repo root")
+	}
+
+	dir := dayDir(number)
+	if fileExists(dir) {
+		return fmt.Errorf("%s already exists", dir)
+	}
+
+	if err := os.Mkdir(dir, 0755); err != nil {
+		return err
+	}
+
+	// Don't leave a half-built day behind, it would block the next attempt
+	if err := scaffoldDay(number, dir); err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+
+	fmt.Printf("Created day %02d in %s/ and registered it in %s\n", number, dir, daysFile)
+	return nil
+}
+
+// Fills in the day's new directory and registers it.
+func scaffoldDay(number int, dir string) error {
+	parsed, err := template.ParseFS(templates, "templates/*.tmpl")
+	if err != nil {
+		return err
+	}
+
+	data := struct {
+		Number int
+		Dir    string
+	}{number, dir}
+
+	for name, fileName := range scaffold {
+		var buffer bytes.Buffer
+		if err := parsed.ExecuteTemplate(&buffer, name, data); err != nil {
+			return err
+		}
+
+		source, err := format.Source(buffer.Bytes())
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf(fileName, dir)), source, 0644); err != nil {
+			return err
+		}
+	}
+
+	// Paste the puzzle's example in here for -example and the tests
+	if err := os.WriteFile(filepath.Join(dir, "example"), nil, 0644); err != nil {
+		return err
+	}
+
+	return registerDay(dir)
+}
+
+// Adds the day's import to days.go, keeping the imports in order.
+func registerDay(dir string) error {
+	raw, err := os.ReadFile(daysFile)
+	if err != nil {
+		return err
+	}
+
+	importLine := fmt.Sprintf("\t_ \"AoC_2023/%s\"", dir)
+	lines := strings.Split(string(raw), "\n")
+	if slices.Contains(lines, importLine) {
+		return nil
+	}
+
+	start := slices.Index(lines, "import (")
+	if start < 0 {
+		return fmt.Errorf("couldn't find the import block in %s", daysFile)
+	}
+	end := slices.Index(lines[start:], ")")
+	if end < 0 {
+		return fmt.Errorf("the import block in %s is never closed", daysFile)
+	}
+	end += start
+
+	insertAt := end
+	for i := start + 1; i < end; i++ {
+		if lines[i] > importLine {
+			insertAt = i
+			break
+		}
+	}
+
+	lines = slices.Insert(lines, insertAt, importLine)
+	source, err := format.Source([]byte(strings.Join(lines, "\n")))
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(daysFile, source, 0644)
+}
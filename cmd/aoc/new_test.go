@@ -0,0 +1,76 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Moves into a scratch repo whose days.go holds days, since newDay works
+// relative to the repo root.
+func scratchRepo(t *testing.T, days string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(daysFile)), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for path, contents := range map[string]string{"go.mod": "module AoC_2023\n", daysFile: days} {
+		if err := os.WriteFile(filepath.Join(dir, path), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+}
+
+func TestNewDay(t *testing.T) {
+	scratchRepo(t, "package main\n\nimport (\n\t_ \"AoC_2023/01\"\n\t_ \"AoC_2023/03\"\n)\n")
+
+	if err := newDay([]string{"2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{"02/day02.go", "02/day02_test.go", "02/example"} {
+		if !fileExists(path) {
+			t.Errorf("%s wasn't created", path)
+		}
+	}
+
+	days, _ := os.ReadFile(daysFile)
+	want := "import (\n\t_ \"AoC_2023/01\"\n\t_ \"AoC_2023/02\"\n\t_ \"AoC_2023/03\"\n)"
+	if !strings.Contains(string(days), want) {
+		t.Errorf("got days.go\n%s\nwant the imports\n%s", days, want)
+	}
+}
+
+func TestNewDayCleansUpOnFailure(t *testing.T) {
+	tests := []struct {
+		name string
+		days string
+	}{
+		{"no import block", "package main\n"},
+		{"unclosed import block", "package main\n\nimport (\n\t_ \"AoC_2023/01\"\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scratchRepo(t, test.days)
+
+			if err := newDay([]string{"2"}); err == nil || !strings.Contains(err.Error(), "import block") {
+				t.Errorf("got error %v, want one about the import block", err)
+			}
+			if fileExists("02") {
+				t.Error("the half-built day was left behind")
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// How long to back off when the site says we're submitting too quickly but
+// doesn't say for how long.
+const defaultRateLimitWait = time.Minute
+
+// Swapped out by tests so they don't have to sit through the waits.
+var sleep = time.Sleep
+
+func submit(args []string) error {
+	var source inputSource
+	var baseURL, path string
+	flags := flag.NewFlagSet("submit", flag.ExitOnError)
+	flags.Usage = exitWithUsage
+	source.register(flags)
+	flags.StringVar(&baseURL, "base-url", "", "")
+	flags.StringVar(&path, "answers", answersPath, "")
+	flags.Parse(args)
+
+	if flags.NArg() != 2 {
+		exitWithUsage()
+	}
+
+	if source.example {
+		return errors.New("example answers can't be submitted")
+	}
+
+	days, err := selectDays(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	if len(days) != 1 {
+		return errors.New("submit one day at a time")
+	}
+	day := days[0]
+
+	part, err := strconv.Atoi(flags.Arg(1))
+	if err != nil || part < 1 || part > 2 {
+		return fmt.Errorf("%q is not a part, want 1 or 2", flags.Arg(1))
+	}
+
+	input, err := parse(day, source)
+	if err != nil {
+		return err
+	}
+
+	answer, err := day.CheckedPart1(input)
+	if part == 2 {
+		answer, err = day.CheckedPart2(input)
+	}
+	if err != nil {
+		return fmt.Errorf("day %02d part %d: %w", day.Number, part, err)
+	}
+	fmt.Printf("Day %02d part %d: %d\n", day.Number, part, answer)
+
+	store, err := loadAnswers(path)
+	if err != nil {
+		return err
+	}
+
+	if accepted, ok := store[answerKey{day.Number, part}]; ok {
+		if accepted == answer {
+			fmt.Println("Already accepted, not submitting again")
+			return nil
+		}
+		return fmt.Errorf("%s says %d was accepted, refusing to submit %d", path, accepted, answer)
+	}
+
+	logPath, err := submissionLogPath()
+	if err != nil {
+		return err
+	}
+
+	log, err := loadSubmissionLog(logPath)
+	if err != nil {
+		return err
+	}
+
+	if rejected, ok := log.rulesOut(day.Number, part, answer); ok {
+		return fmt.Errorf("not submitting, %d was already rejected as %v", rejected.answer, rejected.verdict)
+	}
+
+	session, err := loadSession()
+	if err != nil {
+		return err
+	}
+	site := newClient(resolveBaseURL(baseURL), session)
+
+	for {
+		if wait := time.Until(log.waitUntil).Round(time.Second); wait > 0 {
+			fmt.Printf("Waiting %v before submitting\n", wait)
+			sleep(wait)
+		}
+
+		result, err := site.submitAnswer(day.Number, part, answer)
+		if err != nil {
+			return err
+		}
+
+		if result.verdict == rateLimited && result.wait == 0 {
+			result.wait = defaultRateLimitWait
+		}
+		log.waitUntil = time.Now().Add(result.wait)
+
+		switch result.verdict {
+		case rateLimited:
+			if err := log.save(logPath); err != nil {
+				return err
+			}
+			continue
+
+		case correct:
+			if err := log.save(logPath); err != nil {
+				return err
+			}
+			fmt.Println("That's the right answer!")
+			return recordAnswer(path, day.Number, part, answer)
+
+		case alreadySolved:
+			return errors.New("the site says this part is already solved or not unlocked yet")
+
+		default:
+			log.rejected = append(log.rejected, rejection{day.Number, part, answer, result.verdict})
+			if err := log.save(logPath); err != nil {
+				return err
+			}
+			return fmt.Errorf("%d is not the right answer (%v)", answer, result.verdict)
+		}
+	}
+}
+
+type rejection struct {
+	day     int
+	part    int
+	answer  int
+	verdict verdict
+}
+
+// Every answer the site has turned down, so that none of them are sent
+// twice, and the earliest time the site will take another submission. It's
+// stored in the cache directory next to the inputs as
+//
+//	wait-until <RFC 3339 time>
+//	<day> <part> <answer> <verdict>
+//	...
+type submissionLog struct {
+	rejected  []rejection
+	waitUntil time.Time
+}
+
+func submissionLogPath() (string, error) {
+	dir, err := cacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "submissions"), nil
+}
+
+// Whether an earlier rejection already tells us answer is wrong, either
+// because it's the same answer or because it's past a too high or too low.
+func (self *submissionLog) rulesOut(day, part, answer int) (rejection, bool) {
+	for _, r := range self.rejected {
+		if r.day != day || r.part != part {
+			continue
+		}
+
+		if r.answer == answer ||
+			(r.verdict == tooHigh && answer >= r.answer) ||
+			(r.verdict == tooLow && answer <= r.answer) {
+			return r, true
+		}
+	}
+
+	return rejection{}, false
+}
+
+func loadSubmissionLog(path string) (*submissionLog, error) {
+	log := &submissionLog{}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return log, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		fields := strings.Fields(scanner.Text())
+
+		if len(fields) == 2 && fields[0] == "wait-until" {
+			if log.waitUntil, err = time.Parse(time.RFC3339, fields[1]); err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, lineNum, err)
+			}
+			continue
+		}
+
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("%s:%d: want \"<day> <part> <answer> <verdict>\", got %q", path, lineNum, scanner.Text())
+		}
+
+		r := rejection{verdict: parseVerdictName(fields[3])}
+		for i, target := range []*int{&r.day, &r.part, &r.answer} {
+			if *target, err = strconv.Atoi(fields[i]); err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, lineNum, err)
+			}
+		}
+		log.rejected = append(log.rejected, r)
+	}
+
+	return log, scanner.Err()
+}
+
+func (self *submissionLog) save(path string) error {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "wait-until %s\n", self.waitUntil.Format(time.RFC3339))
+	for _, r := range self.rejected {
+		fmt.Fprintf(&builder, "%d %d %d %v\n", r.day, r.part, r.answer, r.verdict)
+	}
+
+	return writeFileAtomic(path, []byte(builder.String()))
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseSubmissionPage(t *testing.T) {
+	tests := []struct {
+		name string
+		page string
+		want submissionResult
+	}{
+		{
+			"correct",
+			"<article><p>That's the right answer! You are one gold star closer to restoring snow operations.</p></article>",
+			submissionResult{correct, 0},
+		},
+		{
+			"wrong",
+			"<article><p>That's not the right answer. If you're stuck, make sure you're using the full input data. Please wait one minute before trying again.</p></article>",
+			submissionResult{wrong, time.Minute},
+		},
+		{
+			"too high",
+			"<article><p>That's not the right answer; your answer is too high. Please wait one minute before trying again.</p></article>",
+			submissionResult{tooHigh, time.Minute},
+		},
+		{
+			"too low",
+			"<article><p>That's not the right answer; your answer is too low. Because you have guessed incorrectly 4 times on this puzzle, please wait 5 minutes before trying again.</p></article>",
+			submissionResult{tooLow, 5 * time.Minute},
+		},
+		{
+			"rate limited with minutes",
+			"<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 23s left to wait.</p></article>",
+			submissionResult{rateLimited, 83 * time.Second},
+		},
+		{
+			"rate limited with seconds",
+			"<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 45s left to wait.</p></article>",
+			submissionResult{rateLimited, 45 * time.Second},
+		},
+		{
+			"already solved",
+			"<article><p>You don't seem to be solving the right level.  Did you already complete it?</p></article>",
+			submissionResult{alreadySolved, 0},
+		},
+		{
+			"unrecognised",
+			"<html><body>Down for maintenance</body></html>",
+			submissionResult{unknownVerdict, 0},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := parseSubmissionPage(test.page); got != test.want {
+				t.Errorf("got %v after %v, want %v after %v", got.verdict, got.wait, test.want.verdict, test.want.wait)
+			}
+		})
+	}
+}
+
+func TestRulesOut(t *testing.T) {
+	log := &submissionLog{rejected: []rejection{
+		{1, 1, 100, tooHigh},
+		{1, 1, 50, tooLow},
+		{1, 2, 70, wrong},
+		{2, 1, 10, tooHigh},
+	}}
+
+	tests := []struct {
+		day, part, answer int
+		want              bool
+	}{
+		{1, 1, 100, true},
+		{1, 1, 150, true},
+		{1, 1, 50, true},
+		{1, 1, 20, true},
+		{1, 1, 75, false},
+		{1, 2, 70, true},
+		{1, 2, 71, false}, // Wrong says nothing about other answers
+		{2, 1, 10, true},
+		{2, 1, 5, false},
+		{2, 2, 10, false},
+		{3, 1, 100, false},
+	}
+
+	for _, test := range tests {
+		if _, got := log.rulesOut(test.day, test.part, test.answer); got != test.want {
+			t.Errorf("rulesOut(%d, %d, %d) = %t, want %t", test.day, test.part, test.answer, got, test.want)
+		}
+	}
+}
+
+// The site first throttles the submission, then turns the answer down. The
+// throttle should be waited out, and the rejected answer never sent again.
+func TestSubmitWaitsOutRateLimitAndRemembersRejection(t *testing.T) {
+	pages := []string{
+		"You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 2s left to wait.",
+		"That's not the right answer; your answer is too high. Please wait one minute before trying again.",
+	}
+
+	posts := 0
+	cache := fakeSite(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2023/day/1/answer" {
+			t.Errorf("got %s %s", r.Method, r.URL.Path)
+		}
+		if r.FormValue("level") != "1" || r.FormValue("answer") != "12" {
+			t.Errorf("got form %v", r.Form)
+		}
+		if posts >= len(pages) {
+			t.Errorf("submitted %d times", posts+1)
+			http.Error(w, "too many submissions", http.StatusTeapot)
+			return
+		}
+		w.Write([]byte(pages[posts]))
+		posts++
+	})
+
+	var waits []time.Duration
+	sleep = func(d time.Duration) { waits = append(waits, d) }
+	t.Cleanup(func() { sleep = time.Sleep })
+
+	dir := t.TempDir()
+	input := filepath.Join(dir, "input")
+	if err := os.WriteFile(input, []byte("1abc2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	args := []string{"-input", input, "-answers", filepath.Join(dir, "answers.txt"), "1", "1"}
+
+	err := submit(args)
+	if err == nil || !strings.Contains(err.Error(), "too-high") {
+		t.Fatalf("got error %v, want a too-high rejection", err)
+	}
+	if posts != 2 {
+		t.Errorf("submitted %d times, want 2", posts)
+	}
+	if len(waits) != 1 || waits[0] <= 0 || waits[0] > 2*time.Second {
+		t.Errorf("waited %v, want about 2s once", waits)
+	}
+
+	log, err := loadSubmissionLog(filepath.Join(cache, "submissions"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := log.rulesOut(1, 1, 12); !ok {
+		t.Errorf("the rejection wasn't logged, got %v", log.rejected)
+	}
+
+	err = submit(args)
+	if err == nil || !strings.Contains(err.Error(), "already rejected") {
+		t.Errorf("got error %v on resubmitting", err)
+	}
+	if posts != 2 {
+		t.Errorf("the rejected answer was submitted again")
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"AoC_2023/lib"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+)
+
+func verify(args []string) error {
+	var source inputSource
+	var path string
+	flags := flag.NewFlagSet("verify", flag.ExitOnError)
+	flags.Usage = exitWithUsage
+	source.register(flags)
+	flags.StringVar(&path, "answers", "", "")
+	flags.Parse(args)
+
+	if flags.NArg() > 1 {
+		exitWithUsage()
+	}
+
+	days := lib.Days()
+	if flags.NArg() == 1 {
+		var err error
+		if days, err = selectDays(flags.Arg(0)); err != nil {
+			return err
+		}
+	}
+
+	if err := source.validate(len(days)); err != nil {
+		return err
+	}
+
+	if path == "" {
+		path = defaultAnswersPath(source)
+	}
+
+	store, err := loadAnswers(path)
+	if err != nil {
+		return err
+	}
+
+	passed, failed, missing, skipped := 0, 0, 0, 0
+	for _, day := range days {
+		answers, err := solve(day, source)
+		if errors.Is(err, fs.ErrNotExist) {
+			fmt.Printf("SKIP     day %02d         no input\n", day.Number)
+			skipped++
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		for i, got := range answers {
+			part := i + 1
+			want, ok := store[answerKey{day.Number, part}]
+
+			switch {
+			case !ok:
+				fmt.Printf("MISSING  day %02d part %d  got %d\n", day.Number, part, got)
+				missing++
+			case got == want:
+				fmt.Printf("PASS     day %02d part %d  %d\n", day.Number, part, got)
+				passed++
+			default:
+				fmt.Printf("FAIL     day %02d part %d  got %d, want %d (off by %+d)\n", day.Number, part, got, want, got-want)
+				failed++
+			}
+		}
+	}
+
+	fmt.Printf("\n%d passed, %d failed, %d missing, %d skipped\n", passed, failed, missing, skipped)
+	if failed > 0 || missing > 0 {
+		return fmt.Errorf("%d answers don't match %s and %d are missing from it", failed, path, missing)
+	}
+
+	return nil
+}
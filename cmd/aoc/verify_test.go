@@ -0,0 +1,85 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Arguments for verifying day 01 against answers, on an input that gives 12
+// for both parts.
+func verifyDay01Args(t *testing.T, answers string) []string {
+	t.Helper()
+
+	input := filepath.Join(t.TempDir(), "input")
+	if err := os.WriteFile(input, []byte("1abc2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return []string{"-input", input, "-answers", writeAnswers(t, answers), "1"}
+}
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name    string
+		answers string
+		want    string
+	}{
+		{"all pass", "1 1 12\n1 2 12\n", ""},
+		{"mismatch", "1 1 12\n1 2 13\n", "1 answers don't match"},
+		{"missing", "1 1 12\n", "1 are missing"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := verify(verifyDay01Args(t, test.answers))
+			if test.want == "" && err != nil {
+				t.Errorf("got error %v", err)
+			}
+			if test.want != "" && (err == nil || !strings.Contains(err.Error(), test.want)) {
+				t.Errorf("got error %v, want one saying %q", err, test.want)
+			}
+		})
+	}
+}
+
+// Runs the test binary itself as aoc, since the exit status comes from main.
+func TestVerifyExitStatus(t *testing.T) {
+	if args := os.Getenv("AOC_TEST_MAIN_ARGS"); args != "" {
+		os.Args = append([]string{"aoc"}, strings.Split(args, "\n")...)
+		main()
+		os.Exit(0)
+	}
+
+	tests := []struct {
+		name     string
+		answers  string
+		wantCode int
+	}{
+		{"all pass", "1 1 12\n1 2 12\n", 0},
+		{"mismatch", "1 1 12\n1 2 13\n", 1},
+		{"missing", "1 1 12\n", 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			args := append([]string{"verify"}, verifyDay01Args(t, test.answers)...)
+			cmd := exec.Command(os.Args[0], "-test.run=^TestVerifyExitStatus$")
+			cmd.Env = append(os.Environ(), "AOC_TEST_MAIN_ARGS="+strings.Join(args, "\n"))
+
+			code := 0
+			var exitErr *exec.ExitError
+			if err := cmd.Run(); errors.As(err, &exitErr) {
+				code = exitErr.ExitCode()
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			if code != test.wantCode {
+				t.Errorf("exited with %d, want %d", code, test.wantCode)
+			}
+		})
+	}
+}
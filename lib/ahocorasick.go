@@ -0,0 +1,195 @@
+package lib
+
+// AhoCorasick finds every occurrence of a set of words in a text in a single
+// pass, however many words there are and however they overlap, so
+// "oneight" holds both "one" and "eight". It works on bytes, which is exact
+// for UTF-8 text as well as ASCII.
+//
+// It keeps a second automaton over the words reversed, so it can also scan
+// backwards from the end of a text, which finds the last match without
+// reading the rest.
+type AhoCorasick[V any] struct {
+	words            []acWord[V]
+	forward, reverse automaton
+}
+
+type acWord[V any] struct {
+	len   int
+	value V
+}
+
+// AhoCorasickMatch is one occurrence of a word, at text[Start:End].
+type AhoCorasickMatch[V any] struct {
+	Start, End int
+	Value      V
+}
+
+// A DFA over byte classes, where every byte that appears in no word shares
+// class 0, which keeps the transition table small.
+type automaton struct {
+	classes [256]int
+	width   int     // The number of byte classes
+	delta   []int32 // The next state from state s on class c is delta[s*width+c]
+	outputs [][]int // The words ending at each state, longest first
+}
+
+// NewAhoCorasick compiles words, each standing for its value. The empty
+// word would match everywhere, so it panics.
+func NewAhoCorasick[V any](words map[string]V) *AhoCorasick[V] {
+	self := &AhoCorasick[V]{}
+	forward, reverse := make([]string, 0, len(words)), make([]string, 0, len(words))
+
+	for word, value := range words {
+		if word == "" {
+			panic("lib.NewAhoCorasick: the empty word matches everywhere")
+		}
+
+		reversed := []byte(word)
+		for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+			reversed[i], reversed[j] = reversed[j], reversed[i]
+		}
+
+		self.words = append(self.words, acWord[V]{len(word), value})
+		forward = append(forward, word)
+		reverse = append(reverse, string(reversed))
+	}
+
+	self.forward = newAutomaton(forward)
+	self.reverse = newAutomaton(reverse)
+	return self
+}
+
+func newAutomaton(words []string) automaton {
+	var self automaton
+	self.width = 1
+	for _, word := range words {
+		for i := 0; i < len(word); i++ {
+			if self.classes[word[i]] == 0 {
+				self.classes[word[i]] = self.width
+				self.width++
+			}
+		}
+	}
+
+	// Build the trie, with -1 for transitions that don't exist yet
+	newState := func() int32 {
+		for c := 0; c < self.width; c++ {
+			self.delta = append(self.delta, -1)
+		}
+		self.outputs = append(self.outputs, nil)
+		return int32(len(self.outputs) - 1)
+	}
+	newState()
+
+	for id, word := range words {
+		state := int32(0)
+		for i := 0; i < len(word); i++ {
+			edge := int(state)*self.width + self.classes[word[i]]
+			if self.delta[edge] < 0 {
+				next := newState() // Grows delta, so no holding pointers into it
+				self.delta[edge] = next
+			}
+			state = self.delta[edge]
+		}
+		self.outputs[state] = append(self.outputs[state], id)
+	}
+
+	// Fill in the missing transitions breadth first, so each state can copy
+	// them from its failure state: the state for the longest proper suffix
+	// of its prefix that's also in the trie. A state's words are its own
+	// followed by its failure state's, which are all shorter.
+	fail := make([]int32, len(self.outputs))
+	queue := NewDeque[int32]()
+	for c := 0; c < self.width; c++ {
+		if next := self.delta[c]; next < 0 {
+			self.delta[c] = 0
+		} else {
+			queue.PushBack(next)
+		}
+	}
+
+	for queue.Len() > 0 {
+		state, _ := queue.PopFront()
+		self.outputs[state] = append(self.outputs[state], self.outputs[fail[state]]...)
+
+		for c := 0; c < self.width; c++ {
+			edge := &self.delta[int(state)*self.width+c]
+			fallback := self.delta[int(fail[state])*self.width+c]
+			if *edge < 0 {
+				*edge = fallback
+			} else {
+				fail[*edge] = fallback
+				queue.PushBack(*edge)
+			}
+		}
+	}
+
+	return self
+}
+
+func (self *automaton) step(state int32, b byte) int32 {
+	return self.delta[int(state)*self.width+self.classes[b]]
+}
+
+// Scan calls yield for every match in text, in the order they end, with the
+// longest first when several end together. It stops early if yield returns
+// false.
+func (self *AhoCorasick[V]) Scan(text string, yield func(AhoCorasickMatch[V]) bool) {
+	state := int32(0)
+	for i := 0; i < len(text); i++ {
+		state = self.forward.step(state, text[i])
+		for _, id := range self.forward.outputs[state] {
+			word := self.words[id]
+			if !yield(AhoCorasickMatch[V]{Start: i + 1 - word.len, End: i + 1, Value: word.value}) {
+				return
+			}
+		}
+	}
+}
+
+// ScanReverse calls yield for every match in text, reading it from the end,
+// so matches come in the reverse of the order they start, with the longest
+// first when several start together. It stops early if yield returns false.
+func (self *AhoCorasick[V]) ScanReverse(text string, yield func(AhoCorasickMatch[V]) bool) {
+	state := int32(0)
+	for i := len(text) - 1; i >= 0; i-- {
+		state = self.reverse.step(state, text[i])
+		for _, id := range self.reverse.outputs[state] {
+			word := self.words[id]
+			if !yield(AhoCorasickMatch[V]{Start: i, End: i + word.len, Value: word.value}) {
+				return
+			}
+		}
+	}
+}
+
+// FindAll lists every match in text, in the order Scan finds them.
+func (self *AhoCorasick[V]) FindAll(text string) []AhoCorasickMatch[V] {
+	matches := make([]AhoCorasickMatch[V], 0)
+	self.Scan(text, func(match AhoCorasickMatch[V]) bool {
+		matches = append(matches, match)
+		return true
+	})
+	return matches
+}
+
+// First is the match that ends first, or false if there are none.
+func (self *AhoCorasick[V]) First(text string) (AhoCorasickMatch[V], bool) {
+	return firstOf(text, self.Scan)
+}
+
+// Last is the match that starts last, or false if there are none. It only
+// reads text back as far as that match.
+func (self *AhoCorasick[V]) Last(text string) (AhoCorasickMatch[V], bool) {
+	return firstOf(text, self.ScanReverse)
+}
+
+func firstOf[V any](text string, scan func(string, func(AhoCorasickMatch[V]) bool)) (AhoCorasickMatch[V], bool) {
+	var first AhoCorasickMatch[V]
+	found := false
+	scan(text, func(match AhoCorasickMatch[V]) bool {
+		first, found = match, true
+		return false
+	})
+	return first, found
+}
@@ -0,0 +1,147 @@
+package lib
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestAhoCorasickFindAll(t *testing.T) {
+	matcher := NewAhoCorasick(map[string]string{"he": "he", "she": "she", "his": "his", "hers": "hers"})
+
+	// The classic example: "she" and "he" end together, and "hers" overlaps both
+	got := matcher.FindAll("ushers")
+	want := []AhoCorasickMatch[string]{{1, 4, "she"}, {2, 4, "he"}, {2, 6, "hers"}}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got := matcher.FindAll("xyz"); len(got) != 0 {
+		t.Errorf("got %v in text with no words", got)
+	}
+}
+
+func TestAhoCorasickOverlappingDigits(t *testing.T) {
+	matcher := NewAhoCorasick(map[string]int{"one": 1, "eight": 8, "two": 2, "nine": 9})
+
+	got := matcher.FindAll("twoneightwonine")
+	want := []AhoCorasickMatch[int]{{0, 3, 2}, {2, 5, 1}, {4, 9, 8}, {8, 11, 2}, {11, 15, 9}}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	first, ok := matcher.First("xoneightx")
+	if !ok || first != (AhoCorasickMatch[int]{1, 4, 1}) {
+		t.Errorf("got first %v, %t", first, ok)
+	}
+	last, ok := matcher.Last("xoneightx")
+	if !ok || last != (AhoCorasickMatch[int]{3, 8, 8}) {
+		t.Errorf("got last %v, %t", last, ok)
+	}
+
+	if _, ok := matcher.Last("nothing"); ok {
+		t.Error("found a last match in text with no words")
+	}
+}
+
+func TestAhoCorasickScanStopsEarly(t *testing.T) {
+	matcher := NewAhoCorasick(map[string]int{"a": 1})
+
+	seen := 0
+	matcher.Scan("aaaa", func(AhoCorasickMatch[int]) bool {
+		seen++
+		return seen < 2
+	})
+	if seen != 2 {
+		t.Errorf("Scan went on to %d matches after being told to stop at 2", seen)
+	}
+
+	// Reverse scans come out latest start first, longest first on ties
+	nested := NewAhoCorasick(map[string]int{"a": 1, "ab": 2, "b": 3})
+	var got []AhoCorasickMatch[int]
+	nested.ScanReverse("abab", func(match AhoCorasickMatch[int]) bool {
+		got = append(got, match)
+		return true
+	})
+	want := []AhoCorasickMatch[int]{{3, 4, 3}, {2, 4, 2}, {2, 3, 1}, {1, 2, 3}, {0, 2, 2}, {0, 1, 1}}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// Checks random word sets over a tiny alphabet, where words overlap all the
+// time, against a search for each word at each position.
+func TestAhoCorasickMatchesNaive(t *testing.T) {
+	rng := rand.New(rand.NewSource(25))
+	randomWord := func(maxLen int) string {
+		word := make([]byte, 1+rng.Intn(maxLen))
+		for i := range word {
+			word[i] = "abc"[rng.Intn(3)]
+		}
+		return string(word)
+	}
+
+	for round := 0; round < 200; round++ {
+		words := make(map[string]int)
+		for i := rng.Intn(6); i >= 0; i-- {
+			words[randomWord(4)] = i
+		}
+		text := randomWord(30) + "xyz" + randomWord(10)
+		matcher := NewAhoCorasick(words)
+
+		want := make([]AhoCorasickMatch[int], 0)
+		for word, value := range words {
+			for start := 0; start+len(word) <= len(text); start++ {
+				if strings.HasPrefix(text[start:], word) {
+					want = append(want, AhoCorasickMatch[int]{start, start + len(word), value})
+				}
+			}
+		}
+
+		// Scan's order: by end, then longest first
+		slices.SortFunc(want, func(a, b AhoCorasickMatch[int]) int {
+			if a.End != b.End {
+				return a.End - b.End
+			}
+			return a.Start - b.Start
+		})
+		if got := matcher.FindAll(text); !slices.Equal(got, want) {
+			t.Fatalf("words %v in %q: got %v, want %v", words, text, got, want)
+		}
+
+		// Last is the latest start, longest first
+		last, ok := matcher.Last(text)
+		var wantLast AhoCorasickMatch[int]
+		for _, match := range want {
+			if match.Start > wantLast.Start || (match.Start == wantLast.Start && match.End > wantLast.End) {
+				wantLast = match
+			}
+		}
+		if ok != (len(want) > 0) || (ok && last != wantLast) {
+			t.Fatalf("words %v in %q: got last %v, want %v", words, text, last, wantLast)
+		}
+	}
+}
+
+func TestNewAhoCorasickRejectsEmptyWord(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("the empty word didn't panic")
+		}
+	}()
+
+	NewAhoCorasick(map[string]int{"": 0})
+}
+
+func BenchmarkAhoCorasick(b *testing.B) {
+	matcher := NewAhoCorasick(map[string]int{
+		"one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6, "seven": 7, "eight": 8, "nine": 9,
+	})
+	text := strings.Repeat("xtwone3fourzoneight234sevenine", 100)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		matcher.Scan(text, func(AhoCorasickMatch[int]) bool { return true })
+	}
+}
@@ -0,0 +1,262 @@
+package lib
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Bitset is a fixed-length set of the ints 0 to Len()-1, packed 64 to a
+// word. Its methods are named after Set's, so it can stand in for a
+// Set[int] when the values are small and dense.
+type Bitset struct {
+	words []uint64
+	len   int
+}
+
+func NewBitset(n int) Bitset {
+	return Bitset{words: make([]uint64, (n+63)/64), len: n}
+}
+
+// Len is how many bits the set has room for, not how many are set.
+func (self Bitset) Len() int {
+	return self.len
+}
+
+func (self Bitset) check(i int) {
+	if i < 0 || i >= self.len {
+		panic(fmt.Sprintf("lib.Bitset: bit %d of %d", i, self.len))
+	}
+}
+
+func (self Bitset) Contains(i int) bool {
+	self.check(i)
+	return self.words[i/64]&(1<<(i%64)) != 0
+}
+
+// Add sets bit i, reporting whether it was clear before.
+func (self *Bitset) Add(i int) bool {
+	self.check(i)
+	word, mask := &self.words[i/64], uint64(1)<<(i%64)
+	added := *word&mask == 0
+	*word |= mask
+	return added
+}
+
+// Remove clears bit i, reporting whether it was set before.
+func (self *Bitset) Remove(i int) bool {
+	self.check(i)
+	word, mask := &self.words[i/64], uint64(1)<<(i%64)
+	removed := *word&mask != 0
+	*word &^= mask
+	return removed
+}
+
+func (self *Bitset) Flip(i int) {
+	self.check(i)
+	self.words[i/64] ^= 1 << (i % 64)
+}
+
+// Reset clears every bit.
+func (self *Bitset) Reset() {
+	clear(self.words)
+}
+
+// Count is the number of bits set.
+func (self Bitset) Count() int {
+	count := 0
+	for _, word := range self.words {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// Next returns the first set bit at or after i, or reports false if there
+// isn't one. Iterate over the set bits with
+//
+//	for i, ok := set.Next(0); ok; i, ok = set.Next(i + 1) {
+func (self Bitset) Next(i int) (int, bool) {
+	if i < 0 {
+		i = 0
+	}
+	if i >= self.len {
+		return 0, false
+	}
+
+	w := i / 64
+	word := self.words[w] >> (i % 64)
+	if word != 0 {
+		return i + bits.TrailingZeros64(word), true
+	}
+
+	for w++; w < len(self.words); w++ {
+		if self.words[w] != 0 {
+			return w*64 + bits.TrailingZeros64(self.words[w]), true
+		}
+	}
+	return 0, false
+}
+
+// Slice lists the set bits in increasing order.
+func (self Bitset) Slice() []int {
+	ones := make([]int, 0, self.Count())
+	for i, ok := self.Next(0); ok; i, ok = self.Next(i + 1) {
+		ones = append(ones, i)
+	}
+	return ones
+}
+
+func (self Bitset) Clone() Bitset {
+	return Bitset{words: append([]uint64(nil), self.words...), len: self.len}
+}
+
+func (self Bitset) Equal(other Bitset) bool {
+	if self.len != other.len {
+		return false
+	}
+	for i, word := range self.words {
+		if word != other.words[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (self Bitset) checkLen(other Bitset) {
+	if self.len != other.len {
+		panic(fmt.Sprintf("lib.Bitset: combining lengths %d and %d", self.len, other.len))
+	}
+}
+
+// And keeps only the bits also set in other, which must be the same length.
+func (self *Bitset) And(other Bitset) {
+	self.checkLen(other)
+	for i := range self.words {
+		self.words[i] &= other.words[i]
+	}
+}
+
+// Or sets every bit set in other, which must be the same length.
+func (self *Bitset) Or(other Bitset) {
+	self.checkLen(other)
+	for i := range self.words {
+		self.words[i] |= other.words[i]
+	}
+}
+
+// Xor flips every bit set in other, which must be the same length.
+func (self *Bitset) Xor(other Bitset) {
+	self.checkLen(other)
+	for i := range self.words {
+		self.words[i] ^= other.words[i]
+	}
+}
+
+// AndNot clears every bit set in other, which must be the same length.
+func (self *Bitset) AndNot(other Bitset) {
+	self.checkLen(other)
+	for i := range self.words {
+		self.words[i] &^= other.words[i]
+	}
+}
+
+// Distance is the number of bits that differ between the two sets, which
+// must be the same length. It's the Count of their Xor without building it.
+func (self Bitset) Distance(other Bitset) int {
+	self.checkLen(other)
+	count := 0
+	for i, word := range self.words {
+		count += bits.OnesCount64(word ^ other.words[i])
+	}
+	return count
+}
+
+// BitGrid is a grid of bits that keeps every row and every column as a
+// Bitset of its own, so whole rows or columns can be compared with a few
+// word operations. Setting a bit costs two writes, one for its row and one
+// for its column.
+type BitGrid struct {
+	rows, cols []Bitset
+}
+
+func NewBitGrid(rows, cols int) BitGrid {
+	grid := BitGrid{rows: make([]Bitset, rows), cols: make([]Bitset, cols)}
+	for i := range grid.rows {
+		grid.rows[i] = NewBitset(cols)
+	}
+	for j := range grid.cols {
+		grid.cols[j] = NewBitset(rows)
+	}
+	return grid
+}
+
+func (self BitGrid) Rows() int {
+	return len(self.rows)
+}
+
+func (self BitGrid) Cols() int {
+	return len(self.cols)
+}
+
+func (self BitGrid) InBounds(p Point) bool {
+	return p.Row >= 0 && p.Row < len(self.rows) && p.Col >= 0 && p.Col < len(self.cols)
+}
+
+func (self BitGrid) Contains(p Point) bool {
+	return self.rows[p.Row].Contains(p.Col)
+}
+
+// Add sets the bit at p, reporting whether it was clear before.
+func (self BitGrid) Add(p Point) bool {
+	self.cols[p.Col].Add(p.Row)
+	return self.rows[p.Row].Add(p.Col)
+}
+
+// Remove clears the bit at p, reporting whether it was set before.
+func (self BitGrid) Remove(p Point) bool {
+	self.cols[p.Col].Remove(p.Row)
+	return self.rows[p.Row].Remove(p.Col)
+}
+
+func (self BitGrid) Flip(p Point) {
+	self.rows[p.Row].Flip(p.Col)
+	self.cols[p.Col].Flip(p.Row)
+}
+
+// Reset clears every bit.
+func (self BitGrid) Reset() {
+	for i := range self.rows {
+		self.rows[i].Reset()
+	}
+	for j := range self.cols {
+		self.cols[j].Reset()
+	}
+}
+
+// Count is the number of bits set.
+func (self BitGrid) Count() int {
+	count := 0
+	for _, row := range self.rows {
+		count += row.Count()
+	}
+	return count
+}
+
+// Row is a copy of row i's bits, one for each column.
+func (self BitGrid) Row(i int) Bitset {
+	return self.rows[i].Clone()
+}
+
+// Col is a copy of column j's bits, one for each row.
+func (self BitGrid) Col(j int) Bitset {
+	return self.cols[j].Clone()
+}
+
+// RowDistance is the number of columns where rows a and b differ.
+func (self BitGrid) RowDistance(a, b int) int {
+	return self.rows[a].Distance(self.rows[b])
+}
+
+// ColDistance is the number of rows where columns a and b differ.
+func (self BitGrid) ColDistance(a, b int) int {
+	return self.cols[a].Distance(self.cols[b])
+}
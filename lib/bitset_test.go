@@ -0,0 +1,151 @@
+package lib
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestBitset(t *testing.T) {
+	set := NewBitset(130)
+
+	for _, i := range []int{0, 63, 64, 129, 5} {
+		if !set.Add(i) {
+			t.Errorf("Add(%d) reported it was already set", i)
+		}
+	}
+	if set.Add(64) {
+		t.Error("adding a set bit reported a change")
+	}
+	if !set.Contains(129) || set.Contains(128) {
+		t.Error("got the wrong bits")
+	}
+
+	want := []int{0, 5, 63, 64, 129}
+	if got := set.Slice(); !slices.Equal(got, want) || set.Count() != 5 {
+		t.Errorf("got %v (%d set), want %v", got, set.Count(), want)
+	}
+
+	if !set.Remove(63) || set.Remove(63) {
+		t.Error("Remove reported the wrong changes")
+	}
+	set.Flip(128)
+	set.Flip(0)
+	want = []int{5, 64, 128, 129}
+	if got := set.Slice(); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if i, ok := set.Next(65); i != 128 || !ok {
+		t.Errorf("Next(65) = %d, %t, want 128", i, ok)
+	}
+	if _, ok := set.Next(130); ok {
+		t.Error("Next past the end found a bit")
+	}
+
+	clone := set.Clone()
+	set.Reset()
+	if set.Count() != 0 || clone.Count() != 4 {
+		t.Errorf("Reset left %d bits, and the clone has %d", set.Count(), clone.Count())
+	}
+}
+
+func TestBitsetOutOfRangePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("adding bit 10 of 10 didn't panic")
+		}
+	}()
+
+	set := NewBitset(10)
+	set.Add(10)
+}
+
+// Checks the set operations against Set[int].
+func TestBitsetOperations(t *testing.T) {
+	rng := rand.New(rand.NewSource(24))
+	const n = 200
+
+	random := func() (Bitset, Set[int]) {
+		bits, members := NewBitset(n), NewSet[int]()
+		for i := rng.Intn(n); i > 0; i-- {
+			x := rng.Intn(n)
+			bits.Add(x)
+			members.Add(x)
+		}
+		return bits, members
+	}
+
+	for round := 0; round < 100; round++ {
+		a, aMembers := random()
+		b, bMembers := random()
+
+		checks := []struct {
+			name    string
+			combine func(*Bitset, Bitset)
+			want    Set[int]
+		}{
+			{"and", (*Bitset).And, aMembers.Intersection(bMembers)},
+			{"or", (*Bitset).Or, aMembers.Union(bMembers)},
+			{"xor", (*Bitset).Xor, aMembers.SymmetricDifference(bMembers)},
+			{"and not", (*Bitset).AndNot, aMembers.Difference(bMembers)},
+		}
+
+		for _, check := range checks {
+			got := a.Clone()
+			check.combine(&got, b)
+
+			if want := Sorted(check.want); !slices.Equal(got.Slice(), want) || got.Count() != len(want) {
+				t.Fatalf("%s: got %v, want %v", check.name, got.Slice(), want)
+			}
+		}
+
+		if want := aMembers.SymmetricDifference(bMembers).Len(); a.Distance(b) != want {
+			t.Fatalf("got distance %d, want %d", a.Distance(b), want)
+		}
+		if a.Equal(b) != aMembers.Equal(bMembers) {
+			t.Fatalf("Equal disagrees with Set.Equal")
+		}
+	}
+}
+
+func TestBitGrid(t *testing.T) {
+	grid := NewBitGrid(3, 70)
+	for _, p := range []Point{{0, 0}, {0, 69}, {2, 69}, {1, 35}} {
+		grid.Add(p)
+	}
+
+	if !grid.Contains(Point{2, 69}) || grid.Contains(Point{1, 69}) || grid.Count() != 4 {
+		t.Error("got the wrong bits")
+	}
+	if got := grid.Row(0).Slice(); !slices.Equal(got, []int{0, 69}) {
+		t.Errorf("got row 0 %v, want [0 69]", got)
+	}
+	if got := grid.Col(69).Slice(); !slices.Equal(got, []int{0, 2}) {
+		t.Errorf("got column 69 %v, want [0 2]", got)
+	}
+
+	// Rows 0 and 2 only differ at column 0, and columns 0 and 69 at row 2
+	if got := grid.RowDistance(0, 2); got != 1 {
+		t.Errorf("got row distance %d, want 1", got)
+	}
+	if got := grid.ColDistance(0, 69); got != 1 {
+		t.Errorf("got column distance %d, want 1", got)
+	}
+
+	if !grid.Remove(Point{0, 0}) || grid.Col(0).Count() != 0 {
+		t.Error("Remove didn't clear the column too")
+	}
+	grid.Flip(Point{1, 35})
+	if grid.Contains(Point{1, 35}) || grid.Col(35).Count() != 0 {
+		t.Error("Flip didn't clear the column too")
+	}
+
+	grid.Reset()
+	if grid.Count() != 0 || grid.Col(69).Count() != 0 {
+		t.Error("Reset left bits set")
+	}
+	if grid.InBounds(Point{3, 0}) || !grid.InBounds(Point{2, 69}) {
+		t.Error("got the wrong bounds")
+	}
+}
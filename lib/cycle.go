@@ -0,0 +1,48 @@
+package lib
+
+// Cycle is a sequence of states that, after the first Start of them, repeats
+// every Period steps. It keeps the states up to the end of the first period
+// so it can say what the state is after any number of steps.
+type Cycle[S any] struct {
+	Start, Period int
+	states        []S
+}
+
+// FindCycle steps from start until it sees a state it's seen before. Two
+// states are the same if key gives them the same value, so key has to be
+// exact: anything lossy, like a hash, can mistake two different states for
+// a repeat. step mustn't change the state it's given, since the states are
+// kept for At. If the states never repeat, this never returns.
+func FindCycle[S any, K comparable](start S, step func(S) S, key func(S) K) Cycle[S] {
+	seen := make(map[K]int)
+	states := make([]S, 0)
+
+	for state := start; ; state = step(state) {
+		k := key(state)
+		if first, ok := seen[k]; ok {
+			return Cycle[S]{Start: first, Period: len(states) - first, states: states}
+		}
+
+		seen[k] = len(states)
+		states = append(states, state)
+	}
+}
+
+// FindCycleComparable is FindCycle for states that can be their own key.
+func FindCycleComparable[S comparable](start S, step func(S) S) Cycle[S] {
+	return FindCycle(start, step, func(state S) S { return state })
+}
+
+// Index maps step n to the step in the first pass through the cycle that
+// has the same state.
+func (self Cycle[S]) Index(n int) int {
+	if n < self.Start {
+		return n
+	}
+	return self.Start + (n-self.Start)%self.Period
+}
+
+// At is the state after n steps.
+func (self Cycle[S]) At(n int) S {
+	return self.states[self.Index(n)]
+}
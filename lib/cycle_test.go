@@ -0,0 +1,80 @@
+package lib
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFindCycle(t *testing.T) {
+	tests := []struct {
+		name                  string
+		first                 int
+		step                  func(int) int
+		wantStart, wantPeriod int
+	}{
+		// 0 1 2 3 4 5 6 | 3 4 5 6 ...
+		{"tail", 0, func(n int) int {
+			if n == 6 {
+				return 3
+			}
+			return n + 1
+		}, 3, 4},
+		{"no tail", 2, func(n int) int { return (n + 1) % 5 }, 0, 5},
+		{"fixed point", 7, func(n int) int { return 7 }, 0, 1},
+		// Squaring modulo 21 from 2: 2 4 16 4 ...
+		{"squares", 2, func(n int) int { return n * n % 21 }, 1, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cycle := FindCycleComparable(test.first, test.step)
+			if cycle.Start != test.wantStart || cycle.Period != test.wantPeriod {
+				t.Fatalf("got start %d period %d, want start %d period %d", cycle.Start, cycle.Period, test.wantStart, test.wantPeriod)
+			}
+
+			state := test.first
+			for n := 0; n < 50; n++ {
+				if got := cycle.At(n); got != state {
+					t.Fatalf("At(%d) = %d, want %d", n, got, state)
+				}
+				state = test.step(state)
+			}
+		})
+	}
+}
+
+func TestFindCycleAtLargeN(t *testing.T) {
+	// A linear congruential generator with a short period
+	step := func(n int) int { return (5*n + 3) % 64 }
+	cycle := FindCycleComparable(1, step)
+
+	n := 1_000_000_007
+	want := 1
+	for i := 0; i < n%cycle.Period; i++ {
+		want = step(want)
+	}
+
+	if cycle.Start != 0 {
+		t.Errorf("got start %d, want 0", cycle.Start)
+	}
+	if got := cycle.At(n); got != want {
+		t.Errorf("At(%d) = %d, want %d", n, got, want)
+	}
+}
+
+func TestFindCycleWithKey(t *testing.T) {
+	// Slices aren't comparable, so key them by their contents
+	rotate := func(s []int) []int { return append(s[1:len(s):len(s)], s[0]) }
+	key := func(s []int) string { return fmt.Sprint(s) }
+
+	cycle := FindCycle([]int{1, 2, 3}, rotate, key)
+	if cycle.Start != 0 || cycle.Period != 3 {
+		t.Fatalf("got start %d period %d, want start 0 period 3", cycle.Start, cycle.Period)
+	}
+	if got := key(cycle.At(3_000_000_002)); got != "[3 1 2]" {
+		t.Errorf("got %s, want [3 1 2]", got)
+	}
+	if got := cycle.Index(3_000_000_002); got != 2 {
+		t.Errorf("got index %d, want 2", got)
+	}
+}
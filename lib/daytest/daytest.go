@@ -0,0 +1,99 @@
+// Package daytest holds what every day's tests have in common: parsing input
+// with the day's solver, checking the answers for its example, and
+// benchmarking each part.
+package daytest
+
+import (
+	"AoC_2023/lib"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+// ParseFile parses the file at path, failing tb if it can't be read or
+// parsed.
+func ParseFile[T any](tb testing.TB, solver lib.Solver[T], path string) T {
+	tb.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := solver.Parse(file)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return input
+}
+
+// ParseString parses input, failing tb if it can't be parsed.
+func ParseString[T any](tb testing.TB, solver lib.Solver[T], input string) T {
+	tb.Helper()
+
+	parsed, err := solver.Parse(strings.NewReader(input))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return parsed
+}
+
+// ParseError checks that err is a *lib.ParseError for day pointing at line
+// and column, and returns it for any further checks.
+func ParseError(t *testing.T, err error, day, line, column int) *lib.ParseError {
+	t.Helper()
+
+	var parseErr *lib.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("got %v, want a *lib.ParseError", err)
+	}
+
+	if parseErr.Day != day || parseErr.Line != line || parseErr.Column != column {
+		t.Errorf("got day %d line %d column %d, want day %d line %d column %d (%v)",
+			parseErr.Day, parseErr.Line, parseErr.Column, day, line, column, err)
+	}
+	return parseErr
+}
+
+// Example checks both parts' answers for the input at path, each as its own
+// subtest.
+func Example[T any](t *testing.T, solver lib.Solver[T], path string, part1, part2 int) {
+	t.Helper()
+	input := ParseFile(t, solver, path)
+
+	tests := []struct {
+		name string
+		part func(T) int
+		want int
+	}{
+		{"part 1", solver.Part1, part1},
+		{"part 2", solver.Part2, part2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.part(input); got != test.want {
+				t.Errorf("got %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+// BenchmarkPart1 times part 1 on the input at path, leaving parsing out.
+func BenchmarkPart1[T any](b *testing.B, solver lib.Solver[T], path string) {
+	benchmark(b, ParseFile(b, solver, path), solver.Part1)
+}
+
+// BenchmarkPart2 times part 2 on the input at path, leaving parsing out.
+func BenchmarkPart2[T any](b *testing.B, solver lib.Solver[T], path string) {
+	benchmark(b, ParseFile(b, solver, path), solver.Part2)
+}
+
+func benchmark[T any](b *testing.B, input T, part func(T) int) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		part(input)
+	}
+}
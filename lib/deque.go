@@ -0,0 +1,111 @@
+package lib
+
+// Deque is a double-ended queue backed by a ring buffer, so it works as
+// either a stack or a queue without holding on to memory that's already been
+// popped. The zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	len  int
+}
+
+func NewDeque[T any]() Deque[T] {
+	return Deque[T]{}
+}
+
+func (self Deque[T]) Len() int {
+	return self.len
+}
+
+func (self *Deque[T]) PushBack(val T) {
+	self.grow()
+	self.buf[self.wrap(self.head+self.len)] = val
+	self.len++
+}
+
+func (self *Deque[T]) PushFront(val T) {
+	self.grow()
+	self.head = self.wrap(self.head - 1 + len(self.buf))
+	self.buf[self.head] = val
+	self.len++
+}
+
+// PopFront removes and returns the first element, or reports false if the
+// deque is empty.
+func (self *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if self.len == 0 {
+		return zero, false
+	}
+
+	val := self.buf[self.head]
+	self.buf[self.head] = zero // Don't keep popped values alive
+	self.head = self.wrap(self.head + 1)
+	self.len--
+	self.shrink()
+	return val, true
+}
+
+// PopBack removes and returns the last element, or reports false if the
+// deque is empty.
+func (self *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if self.len == 0 {
+		return zero, false
+	}
+
+	tail := self.wrap(self.head + self.len - 1)
+	val := self.buf[tail]
+	self.buf[tail] = zero
+	self.len--
+	self.shrink()
+	return val, true
+}
+
+func (self Deque[T]) PeekFront() (T, bool) {
+	if self.len == 0 {
+		var zero T
+		return zero, false
+	}
+	return self.buf[self.head], true
+}
+
+func (self Deque[T]) PeekBack() (T, bool) {
+	if self.len == 0 {
+		var zero T
+		return zero, false
+	}
+	return self.buf[self.wrap(self.head+self.len-1)], true
+}
+
+// The buffer's length is always a power of two so wrapping is a mask.
+func (self Deque[T]) wrap(i int) int {
+	return i & (len(self.buf) - 1)
+}
+
+func (self *Deque[T]) grow() {
+	if self.len < len(self.buf) {
+		return
+	}
+	self.resize(max(2*len(self.buf), 8))
+}
+
+// Halves the buffer once it's a quarter full, so a deque that was briefly
+// large gives the memory back.
+func (self *Deque[T]) shrink() {
+	if len(self.buf) > 8 && self.len <= len(self.buf)/4 {
+		self.resize(len(self.buf) / 2)
+	}
+}
+
+func (self *Deque[T]) resize(size int) {
+	buf := make([]T, size)
+	if self.head+self.len <= len(self.buf) {
+		copy(buf, self.buf[self.head:self.head+self.len])
+	} else {
+		n := copy(buf, self.buf[self.head:])
+		copy(buf[n:], self.buf[:self.len-n])
+	}
+	self.buf = buf
+	self.head = 0
+}
@@ -0,0 +1,121 @@
+package lib
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestDequeEmpty(t *testing.T) {
+	var deque Deque[int]
+
+	if _, ok := deque.PopFront(); ok {
+		t.Errorf("PopFront on an empty deque reported a value")
+	}
+	if _, ok := deque.PopBack(); ok {
+		t.Errorf("PopBack on an empty deque reported a value")
+	}
+	if _, ok := deque.PeekFront(); ok {
+		t.Errorf("PeekFront on an empty deque reported a value")
+	}
+	if _, ok := deque.PeekBack(); ok {
+		t.Errorf("PeekBack on an empty deque reported a value")
+	}
+}
+
+func TestDequeAsStackAndQueue(t *testing.T) {
+	deque := NewDeque[int]()
+	for i := 0; i < 20; i++ {
+		deque.PushBack(i)
+	}
+
+	for want := 19; want >= 10; want-- {
+		if got, _ := deque.PopBack(); got != want {
+			t.Fatalf("PopBack: got %d, want %d", got, want)
+		}
+	}
+
+	for want := 0; want < 10; want++ {
+		if got, _ := deque.PopFront(); got != want {
+			t.Fatalf("PopFront: got %d, want %d", got, want)
+		}
+	}
+
+	if deque.Len() != 0 {
+		t.Errorf("got length %d, want 0", deque.Len())
+	}
+}
+
+// Runs random operations against the deque and a plain slice, crossing the
+// wrap-around point and growing and shrinking the buffer along the way.
+func TestDequeRandomOperations(t *testing.T) {
+	rng := rand.New(rand.NewSource(12))
+	var deque Deque[int]
+	var model []int
+
+	for i := 0; i < 20000; i++ {
+		// Drift between growing and draining so the buffer resizes both ways
+		pushBias := 3
+		if (i/2000)%2 == 1 {
+			pushBias = 1
+		}
+
+		switch op := rng.Intn(4 + pushBias); {
+		case op == 0:
+			got, ok := deque.PopFront()
+			if ok != (len(model) > 0) || (ok && got != model[0]) {
+				t.Fatalf("PopFront: got %d %t, model %v", got, ok, model)
+			}
+			if ok {
+				model = model[1:]
+			}
+		case op == 1:
+			got, ok := deque.PopBack()
+			if ok != (len(model) > 0) || (ok && got != model[len(model)-1]) {
+				t.Fatalf("PopBack: got %d %t, model %v", got, ok, model)
+			}
+			if ok {
+				model = model[:len(model)-1]
+			}
+		case op == 2:
+			deque.PushFront(i)
+			model = append([]int{i}, model...)
+		default:
+			deque.PushBack(i)
+			model = append(model, i)
+		}
+
+		if deque.Len() != len(model) {
+			t.Fatalf("got length %d, want %d", deque.Len(), len(model))
+		}
+		if front, ok := deque.PeekFront(); ok && front != model[0] {
+			t.Fatalf("PeekFront: got %d, want %d", front, model[0])
+		}
+		if back, ok := deque.PeekBack(); ok && back != model[len(model)-1] {
+			t.Fatalf("PeekBack: got %d, want %d", back, model[len(model)-1])
+		}
+	}
+
+	var rest []int
+	for deque.Len() > 0 {
+		v, _ := deque.PopFront()
+		rest = append(rest, v)
+	}
+	if !slices.Equal(rest, model) {
+		t.Errorf("got %v, want %v", rest, model)
+	}
+}
+
+func TestDequeReleasesMemory(t *testing.T) {
+	var deque Deque[int]
+	for i := 0; i < 1<<16; i++ {
+		deque.PushBack(i)
+	}
+	for deque.Len() > 1 {
+		deque.PopFront()
+	}
+
+	if size := len(deque.buf); size > 8 {
+		t.Errorf("buffer still holds %d slots for 1 element", size)
+	}
+}
@@ -0,0 +1,214 @@
+package lib
+
+import "fmt"
+
+// DenseDisjointSet is a union-find over the ints 0 to Len()-1. Find
+// compresses paths and Union joins by rank, so any sequence of operations
+// runs in practically constant time each.
+type DenseDisjointSet struct {
+	parent []int
+	rank   []uint8 // Bounded by log2 of the size, so a byte is plenty
+	size   []int   // Only meaningful for roots
+	count  int
+}
+
+// NewDenseDisjointSet starts with n elements, each in its own component.
+func NewDenseDisjointSet(n int) *DenseDisjointSet {
+	set := &DenseDisjointSet{
+		parent: make([]int, n),
+		rank:   make([]uint8, n),
+		size:   make([]int, n),
+		count:  n,
+	}
+	for i := range set.parent {
+		set.parent[i] = i
+		set.size[i] = 1
+	}
+	return set
+}
+
+// Add makes a new element in a component of its own and returns it.
+func (self *DenseDisjointSet) Add() int {
+	x := len(self.parent)
+	self.parent = append(self.parent, x)
+	self.rank = append(self.rank, 0)
+	self.size = append(self.size, 1)
+	self.count++
+	return x
+}
+
+// Len is the number of elements.
+func (self *DenseDisjointSet) Len() int {
+	return len(self.parent)
+}
+
+// Count is the number of components.
+func (self *DenseDisjointSet) Count() int {
+	return self.count
+}
+
+// Find returns the representative of x's component.
+func (self *DenseDisjointSet) Find(x int) int {
+	if x < 0 || x >= len(self.parent) {
+		panic(fmt.Sprintf("lib.DenseDisjointSet: element %d of %d", x, len(self.parent)))
+	}
+
+	root := x
+	for self.parent[root] != root {
+		root = self.parent[root]
+	}
+
+	// Point everything on the way straight at the root
+	for self.parent[x] != root {
+		x, self.parent[x] = self.parent[x], root
+	}
+
+	return root
+}
+
+// Union joins the components of a and b, reporting false if they were
+// already the same component.
+func (self *DenseDisjointSet) Union(a, b int) bool {
+	a, b = self.Find(a), self.Find(b)
+	if a == b {
+		return false
+	}
+
+	// Hang the shallower tree under the deeper one
+	if self.rank[a] < self.rank[b] {
+		a, b = b, a
+	}
+	if self.rank[a] == self.rank[b] {
+		self.rank[a]++
+	}
+
+	self.parent[b] = a
+	self.size[a] += self.size[b]
+	self.count--
+	return true
+}
+
+func (self *DenseDisjointSet) Connected(a, b int) bool {
+	return self.Find(a) == self.Find(b)
+}
+
+// Size is the number of elements in x's component.
+func (self *DenseDisjointSet) Size(x int) int {
+	return self.size[self.Find(x)]
+}
+
+// Components lists every component, ordered by their smallest element, with
+// each one's elements in increasing order.
+func (self *DenseDisjointSet) Components() [][]int {
+	components := make([][]int, 0, self.count)
+	index := make([]int, len(self.parent)) // From a root to its component, plus one
+
+	for x := range self.parent {
+		root := self.Find(x)
+		if index[root] == 0 {
+			components = append(components, make([]int, 0, self.size[root]))
+			index[root] = len(components)
+		}
+		i := index[root] - 1
+		components[i] = append(components[i], x)
+	}
+
+	return components
+}
+
+// DisjointSet is a union-find over any comparable values, built on a
+// DenseDisjointSet by numbering values as they're first seen. Values don't
+// need adding before use: Find and Union add any they haven't seen yet.
+type DisjointSet[T comparable] struct {
+	ids    map[T]int
+	values []T
+	dense  DenseDisjointSet
+}
+
+func NewDisjointSet[T comparable]() *DisjointSet[T] {
+	return &DisjointSet[T]{ids: make(map[T]int)}
+}
+
+// Add puts val in a component of its own, reporting false if it was
+// already in the set.
+func (self *DisjointSet[T]) Add(val T) bool {
+	if _, ok := self.ids[val]; ok {
+		return false
+	}
+	self.id(val)
+	return true
+}
+
+func (self *DisjointSet[T]) id(val T) int {
+	id, ok := self.ids[val]
+	if !ok {
+		id = self.dense.Add()
+		self.ids[val] = id
+		self.values = append(self.values, val)
+	}
+	return id
+}
+
+func (self *DisjointSet[T]) Contains(val T) bool {
+	_, ok := self.ids[val]
+	return ok
+}
+
+// Len is the number of values.
+func (self *DisjointSet[T]) Len() int {
+	return len(self.values)
+}
+
+// Count is the number of components.
+func (self *DisjointSet[T]) Count() int {
+	return self.dense.Count()
+}
+
+// Find returns the representative of val's component.
+func (self *DisjointSet[T]) Find(val T) T {
+	return self.values[self.dense.Find(self.id(val))]
+}
+
+// Union joins the components of a and b, reporting false if they were
+// already the same component.
+func (self *DisjointSet[T]) Union(a, b T) bool {
+	return self.dense.Union(self.id(a), self.id(b))
+}
+
+// Connected reports whether a and b are in the same component. Values that
+// aren't in the set are only connected to themselves.
+func (self *DisjointSet[T]) Connected(a, b T) bool {
+	idA, okA := self.ids[a]
+	idB, okB := self.ids[b]
+	if !okA || !okB {
+		return a == b
+	}
+	return self.dense.Connected(idA, idB)
+}
+
+// Size is the number of values in val's component, or 0 if val isn't in
+// the set.
+func (self *DisjointSet[T]) Size(val T) int {
+	id, ok := self.ids[val]
+	if !ok {
+		return 0
+	}
+	return self.dense.Size(id)
+}
+
+// Components lists every component. They're ordered by whichever of their
+// values was seen first, and the values in each are in the order they were
+// first seen.
+func (self *DisjointSet[T]) Components() [][]T {
+	ids := self.dense.Components()
+	components := make([][]T, len(ids))
+
+	for i, component := range ids {
+		components[i] = make([]T, len(component))
+		for j, id := range component {
+			components[i][j] = self.values[id]
+		}
+	}
+
+	return components
+}
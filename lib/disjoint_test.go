@@ -0,0 +1,170 @@
+package lib
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestDenseDisjointSet(t *testing.T) {
+	set := NewDenseDisjointSet(8)
+
+	if !set.Union(0, 1) || !set.Union(2, 3) || !set.Union(1, 3) || !set.Union(5, 6) {
+		t.Fatal("joining separate components reported no change")
+	}
+	if set.Union(0, 2) {
+		t.Error("joining a component with itself reported a change")
+	}
+
+	if !set.Connected(0, 3) || set.Connected(0, 4) || !set.Connected(6, 5) {
+		t.Error("got the wrong connections")
+	}
+	if set.Size(2) != 4 || set.Size(4) != 1 || set.Size(6) != 2 {
+		t.Errorf("got sizes %d %d %d, want 4 1 2", set.Size(2), set.Size(4), set.Size(6))
+	}
+	if set.Count() != 4 || set.Len() != 8 {
+		t.Errorf("got %d components of %d elements, want 4 of 8", set.Count(), set.Len())
+	}
+
+	want := [][]int{{0, 1, 2, 3}, {4}, {5, 6}, {7}}
+	if got := set.Components(); !slices.EqualFunc(got, want, slices.Equal[[]int]) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if x := set.Add(); x != 8 || set.Count() != 5 || set.Size(x) != 1 {
+		t.Errorf("Add gave %d with %d components", x, set.Count())
+	}
+}
+
+// Checks random unions against components found by labelling.
+func TestDenseDisjointSetMatchesLabels(t *testing.T) {
+	rng := rand.New(rand.NewSource(22))
+
+	for round := 0; round < 50; round++ {
+		n := 1 + rng.Intn(60)
+		set := NewDenseDisjointSet(n)
+		labels := make([]int, n)
+		for i := range labels {
+			labels[i] = i
+		}
+
+		for i := rng.Intn(n); i > 0; i-- {
+			a, b := rng.Intn(n), rng.Intn(n)
+			merged := labels[a] != labels[b]
+			if got := set.Union(a, b); got != merged {
+				t.Fatalf("Union(%d, %d) = %t, want %t", a, b, got, merged)
+			}
+
+			// Relabel b's component as a's
+			old := labels[b]
+			for j := range labels {
+				if labels[j] == old {
+					labels[j] = labels[a]
+				}
+			}
+		}
+
+		sizes := make(map[int]int)
+		for _, label := range labels {
+			sizes[label]++
+		}
+		if set.Count() != len(sizes) {
+			t.Fatalf("got %d components, want %d", set.Count(), len(sizes))
+		}
+
+		for a := 0; a < n; a++ {
+			if set.Size(a) != sizes[labels[a]] {
+				t.Fatalf("Size(%d) = %d, want %d", a, set.Size(a), sizes[labels[a]])
+			}
+			b := rng.Intn(n)
+			if got := set.Connected(a, b); got != (labels[a] == labels[b]) {
+				t.Fatalf("Connected(%d, %d) = %t", a, b, got)
+			}
+		}
+	}
+}
+
+func TestDisjointSet(t *testing.T) {
+	set := NewDisjointSet[string]()
+	set.Add("lonely")
+	set.Union("a", "b")
+	set.Union("c", "d")
+	set.Union("b", "d")
+
+	if set.Add("a") {
+		t.Error("Add of a value already there reported a change")
+	}
+	if root := set.Find("c"); root != set.Find("a") {
+		t.Errorf("c and a have different roots")
+	}
+	if !set.Connected("a", "d") || set.Connected("a", "lonely") {
+		t.Error("got the wrong connections")
+	}
+	if !set.Connected("missing", "missing") || set.Connected("missing", "a") || set.Contains("missing") {
+		t.Error("values not in the set should only connect to themselves")
+	}
+	if set.Size("b") != 4 || set.Size("missing") != 0 {
+		t.Errorf("got sizes %d and %d, want 4 and 0", set.Size("b"), set.Size("missing"))
+	}
+
+	want := [][]string{{"lonely"}, {"a", "b", "c", "d"}}
+	if got := set.Components(); !slices.EqualFunc(got, want, slices.Equal[[]string]) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if set.Len() != 5 || set.Count() != 2 {
+		t.Errorf("got %d components of %d values, want 2 of 5", set.Count(), set.Len())
+	}
+}
+
+// Groups the galaxies from day 11's example into clusters of galaxies
+// within a Manhattan distance of 4 of each other.
+func TestDisjointSetClusters(t *testing.T) {
+	galaxies := []Point{{0, 3}, {1, 7}, {2, 0}, {4, 6}, {5, 1}, {6, 9}, {8, 7}, {9, 0}, {9, 4}}
+
+	set := NewDisjointSet[Point]()
+	for i, a := range galaxies {
+		set.Add(a)
+		for _, b := range galaxies[:i] {
+			if Manhattan(a, b) <= 4 {
+				set.Union(a, b)
+			}
+		}
+	}
+
+	want := [][]Point{{{0, 3}}, {{1, 7}, {4, 6}}, {{2, 0}, {5, 1}}, {{6, 9}, {8, 7}, {9, 0}, {9, 4}}}
+	if got := set.Components(); !slices.EqualFunc(got, want, slices.Equal[[]Point]) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func BenchmarkDenseDisjointSet(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	pairs := make([][2]int, 100_000)
+	for i := range pairs {
+		pairs[i] = [2]int{rng.Intn(100_000), rng.Intn(100_000)}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		set := NewDenseDisjointSet(100_000)
+		for _, pair := range pairs {
+			set.Union(pair[0], pair[1])
+		}
+	}
+}
+
+func BenchmarkDisjointSet(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	pairs := make([][2]Point, 100_000)
+	for i := range pairs {
+		pairs[i] = [2]Point{{rng.Intn(300), rng.Intn(300)}, {rng.Intn(300), rng.Intn(300)}}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		set := NewDisjointSet[Point]()
+		for _, pair := range pairs {
+			set.Union(pair[0], pair[1])
+		}
+	}
+}
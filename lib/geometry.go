@@ -0,0 +1,127 @@
+package lib
+
+import "fmt"
+
+// Point is a position on a grid, with rows counted down from the top and
+// columns counted right from the left, the way puzzle input is read.
+type Point struct {
+	Row int
+	Col int
+}
+
+// Vec is the offset between two points.
+type Vec struct {
+	Row int
+	Col int
+}
+
+func (self Point) Add(v Vec) Point {
+	return Point{self.Row + v.Row, self.Col + v.Col}
+}
+
+// Sub returns the offset that takes other to self.
+func (self Point) Sub(other Point) Vec {
+	return Vec{self.Row - other.Row, self.Col - other.Col}
+}
+
+// Move returns the point n steps away in direction d.
+func (self Point) Move(d Direction, n int) Point {
+	return self.Add(d.Delta().Scale(n))
+}
+
+func (self Vec) Add(other Vec) Vec {
+	return Vec{self.Row + other.Row, self.Col + other.Col}
+}
+
+func (self Vec) Scale(n int) Vec {
+	return Vec{self.Row * n, self.Col * n}
+}
+
+// Manhattan is the number of orthogonal steps between two points.
+func Manhattan(a, b Point) int {
+	return abs(a.Row-b.Row) + abs(a.Col-b.Col)
+}
+
+// Chebyshev is the number of steps between two points when diagonal steps
+// are allowed.
+func Chebyshev(a, b Point) int {
+	return max(abs(a.Row-b.Row), abs(a.Col-b.Col))
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// Direction is one of the four orthogonal directions, in clockwise order
+// starting from Up.
+type Direction int
+
+const (
+	Up Direction = iota
+	Right
+	Down
+	Left
+)
+
+// Directions lists every direction in clockwise order from Up.
+var Directions = [4]Direction{Up, Right, Down, Left}
+
+var orthogonals = [4]Vec{Up: {-1, 0}, Right: {0, 1}, Down: {1, 0}, Left: {0, -1}}
+
+// ParseDirection reads a direction written as U/R/D/L, an arrow (^>v<) or a
+// compass letter (N/E/S/W).
+func ParseDirection(s string) (Direction, bool) {
+	switch s {
+	case "U", "^", "N":
+		return Up, true
+	case "R", ">", "E":
+		return Right, true
+	case "D", "v", "S":
+		return Down, true
+	case "L", "<", "W":
+		return Left, true
+	}
+	return 0, false
+}
+
+func (self Direction) Clockwise() Direction {
+	return (self + 1) % 4
+}
+
+func (self Direction) Anticlockwise() Direction {
+	return (self + 3) % 4
+}
+
+func (self Direction) Opposite() Direction {
+	return (self + 2) % 4
+}
+
+// Delta is the offset of a single step in this direction.
+func (self Direction) Delta() Vec {
+	return orthogonals[self]
+}
+
+func (self Direction) IsHorizontal() bool {
+	return self == Left || self == Right
+}
+
+func (self Direction) IsVertical() bool {
+	return self == Up || self == Down
+}
+
+func (self Direction) String() string {
+	switch self {
+	case Up:
+		return "Up"
+	case Right:
+		return "Right"
+	case Down:
+		return "Down"
+	case Left:
+		return "Left"
+	}
+	return fmt.Sprintf("Direction(%d)", int(self))
+}
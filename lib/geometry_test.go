@@ -0,0 +1,107 @@
+package lib
+
+import "testing"
+
+func TestDirectionTurns(t *testing.T) {
+	tests := []struct {
+		d             Direction
+		clockwise     Direction
+		anticlockwise Direction
+		opposite      Direction
+	}{
+		{Up, Right, Left, Down},
+		{Right, Down, Up, Left},
+		{Down, Left, Right, Up},
+		{Left, Up, Down, Right},
+	}
+
+	for _, test := range tests {
+		if got := test.d.Clockwise(); got != test.clockwise {
+			t.Errorf("%v.Clockwise() = %v, want %v", test.d, got, test.clockwise)
+		}
+		if got := test.d.Anticlockwise(); got != test.anticlockwise {
+			t.Errorf("%v.Anticlockwise() = %v, want %v", test.d, got, test.anticlockwise)
+		}
+		if got := test.d.Opposite(); got != test.opposite {
+			t.Errorf("%v.Opposite() = %v, want %v", test.d, got, test.opposite)
+		}
+	}
+}
+
+func TestDirectionDelta(t *testing.T) {
+	// Rows count down the page, so up is towards row 0
+	origin := Point{5, 5}
+	tests := []struct {
+		d    Direction
+		want Point
+	}{
+		{Up, Point{4, 5}},
+		{Right, Point{5, 6}},
+		{Down, Point{6, 5}},
+		{Left, Point{5, 4}},
+	}
+
+	for _, test := range tests {
+		if got := origin.Move(test.d, 1); got != test.want {
+			t.Errorf("moving %v: got %v, want %v", test.d, got, test.want)
+		}
+
+		// Opposite steps cancel out
+		if sum := test.d.Delta().Add(test.d.Opposite().Delta()); sum != (Vec{}) {
+			t.Errorf("%v and its opposite add to %v", test.d, sum)
+		}
+	}
+
+	if got := origin.Move(Left, 3); got != (Point{5, 2}) {
+		t.Errorf("moving left 3: got %v, want {5 2}", got)
+	}
+}
+
+func TestParseDirection(t *testing.T) {
+	for _, d := range Directions {
+		for _, s := range []string{"URDL", "^>v<", "NESW"} {
+			spelling := string(s[d])
+			if got, ok := ParseDirection(spelling); !ok || got != d {
+				t.Errorf("ParseDirection(%q) = %v %t, want %v", spelling, got, ok, d)
+			}
+		}
+	}
+
+	for _, s := range []string{"", "X", "u", "UU"} {
+		if _, ok := ParseDirection(s); ok {
+			t.Errorf("ParseDirection(%q) succeeded", s)
+		}
+	}
+}
+
+func TestDistances(t *testing.T) {
+	tests := []struct {
+		a, b      Point
+		manhattan int
+		chebyshev int
+	}{
+		{Point{0, 0}, Point{0, 0}, 0, 0},
+		{Point{0, 0}, Point{3, 4}, 7, 4},
+		{Point{-2, 5}, Point{1, -1}, 9, 6},
+	}
+
+	for _, test := range tests {
+		if got := Manhattan(test.a, test.b); got != test.manhattan {
+			t.Errorf("Manhattan(%v, %v) = %d, want %d", test.a, test.b, got, test.manhattan)
+		}
+		if got := Chebyshev(test.b, test.a); got != test.chebyshev {
+			t.Errorf("Chebyshev(%v, %v) = %d, want %d", test.b, test.a, got, test.chebyshev)
+		}
+	}
+}
+
+func TestPointArithmetic(t *testing.T) {
+	a, b := Point{2, 3}, Point{7, -1}
+
+	if got := a.Add(b.Sub(a)); got != b {
+		t.Errorf("a + (b - a) = %v, want %v", got, b)
+	}
+	if got := (Vec{1, -2}).Scale(3); got != (Vec{3, -6}) {
+		t.Errorf("scale: got %v", got)
+	}
+}
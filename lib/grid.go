@@ -0,0 +1,269 @@
+package lib
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Grid is a rectangular grid of cells stored row by row in one slice.
+type Grid[T any] struct {
+	cells  []T
+	rows   int
+	cols   int
+	render func(T) rune
+}
+
+func NewGrid[T any](rows, cols int) Grid[T] {
+	return Grid[T]{cells: make([]T, rows*cols), rows: rows, cols: cols}
+}
+
+// ReadGrid reads one row per line, turning each rune into a cell with parse.
+// A rune that parse rejects, an empty row, or a row that's a different width
+// from the first, is reported as a *ParseError pointing at it. Widths and
+// columns count runes, not bytes.
+func ReadGrid[T any](scanner *Scanner, parse func(rune) (T, bool)) (Grid[T], error) {
+	grid := Grid[T]{cells: make([]T, 0)}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		width := utf8.RuneCountInString(line)
+
+		if width == 0 {
+			return Grid[T]{}, scanner.Errorf(0, line, "row is empty")
+		}
+
+		if grid.rows == 0 {
+			grid.cols = width
+		}
+
+		if width != grid.cols {
+			return Grid[T]{}, scanner.Errorf(0, line, "row is %d wide, want %d like the first row", width, grid.cols)
+		}
+
+		column := 0
+		for _, ch := range line {
+			column++
+			cell, ok := parse(ch)
+			if !ok {
+				return Grid[T]{}, scanner.Errorf(column, string(ch), "unexpected character")
+			}
+			grid.cells = append(grid.cells, cell)
+		}
+		grid.rows++
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Grid[T]{}, err
+	}
+
+	if grid.rows == 0 {
+		return Grid[T]{}, scanner.InputErrorf("input is empty")
+	}
+
+	return grid, nil
+}
+
+// Legend maps the runes in a puzzle's input to cell values.
+type Legend[T comparable] map[rune]T
+
+func (self Legend[T]) Parse(ch rune) (T, bool) {
+	val, ok := self[ch]
+	return val, ok
+}
+
+// Render is the inverse of Parse, returning '?' for a value with no rune.
+func (self Legend[T]) Render(val T) rune {
+	for ch, v := range self {
+		if v == val {
+			return ch
+		}
+	}
+	return '?'
+}
+
+// ReadGridLegend reads a grid through a legend, and remembers the legend so
+// String draws the grid the way it appeared in the input.
+func ReadGridLegend[T comparable](scanner *Scanner, legend Legend[T]) (Grid[T], error) {
+	grid, err := ReadGrid(scanner, legend.Parse)
+	grid.render = legend.Render
+	return grid, err
+}
+
+// WithRender returns the grid with String drawing each cell using render.
+func (self Grid[T]) WithRender(render func(T) rune) Grid[T] {
+	self.render = render
+	return self
+}
+
+func (self Grid[T]) Rows() int {
+	return self.rows
+}
+
+func (self Grid[T]) Cols() int {
+	return self.cols
+}
+
+func (self Grid[T]) InBounds(p Point) bool {
+	return p.Row >= 0 && p.Row < self.rows && p.Col >= 0 && p.Col < self.cols
+}
+
+// Get returns the cell at p, or reports false if p is off the grid.
+func (self Grid[T]) Get(p Point) (T, bool) {
+	if !self.InBounds(p) {
+		var zero T
+		return zero, false
+	}
+	return self.cells[p.Row*self.cols+p.Col], true
+}
+
+// At returns the cell at p, panicking if it's off the grid.
+func (self Grid[T]) At(p Point) T {
+	if !self.InBounds(p) {
+		panic(fmt.Sprintf("%v is outside a %dx%d grid", p, self.rows, self.cols))
+	}
+	return self.cells[p.Row*self.cols+p.Col]
+}
+
+// Set stores val at p, reporting false and doing nothing if p is off the
+// grid.
+func (self Grid[T]) Set(p Point, val T) bool {
+	if !self.InBounds(p) {
+		return false
+	}
+	self.cells[p.Row*self.cols+p.Col] = val
+	return true
+}
+
+// Row returns a view of row i; writing to it writes to the grid.
+func (self Grid[T]) Row(i int) []T {
+	return self.cells[i*self.cols : (i+1)*self.cols : (i+1)*self.cols]
+}
+
+// Col returns a copy of column j, since columns aren't contiguous.
+func (self Grid[T]) Col(j int) []T {
+	col := make([]T, self.rows)
+	for i := range col {
+		col[i] = self.cells[i*self.cols+j]
+	}
+	return col
+}
+
+var diagonals = []Vec{{-1, 1}, {1, 1}, {1, -1}, {-1, -1}}
+
+// Neighbors4 returns the cells above, right of, below and left of p that are
+// on the grid.
+func (self Grid[T]) Neighbors4(p Point) []Point {
+	return self.neighbors(p, orthogonals[:])
+}
+
+// Neighbors8 returns every cell touching p, diagonals included, that's on
+// the grid.
+func (self Grid[T]) Neighbors8(p Point) []Point {
+	return self.neighbors(p, orthogonals[:], diagonals)
+}
+
+func (self Grid[T]) neighbors(p Point, offsetSets ...[]Vec) []Point {
+	neighbors := make([]Point, 0, 8)
+	for _, offsets := range offsetSets {
+		for _, offset := range offsets {
+			neighbor := p.Add(offset)
+			if self.InBounds(neighbor) {
+				neighbors = append(neighbors, neighbor)
+			}
+		}
+	}
+	return neighbors
+}
+
+// FindFunc returns every point whose cell matches, in reading order.
+func (self Grid[T]) FindFunc(match func(T) bool) []Point {
+	points := make([]Point, 0)
+	for i, cell := range self.cells {
+		if match(cell) {
+			points = append(points, Point{i / self.cols, i % self.cols})
+		}
+	}
+	return points
+}
+
+// FindAll returns every point holding val, in reading order.
+func FindAll[T comparable](grid Grid[T], val T) []Point {
+	return grid.FindFunc(func(cell T) bool { return cell == val })
+}
+
+func (self Grid[T]) Clone() Grid[T] {
+	clone := self
+	clone.cells = append(make([]T, 0, len(self.cells)), self.cells...)
+	return clone
+}
+
+// Builds a rows x cols grid where each cell is copied from self at the point
+// source returns.
+func (self Grid[T]) remap(rows, cols int, source func(Point) Point) Grid[T] {
+	grid := NewGrid[T](rows, cols)
+	grid.render = self.render
+
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			grid.cells[i*cols+j] = self.At(source(Point{i, j}))
+		}
+	}
+	return grid
+}
+
+// Transpose returns a new grid mirrored along the main diagonal, so rows
+// become columns.
+func (self Grid[T]) Transpose() Grid[T] {
+	return self.remap(self.cols, self.rows, func(p Point) Point {
+		return Point{p.Col, p.Row}
+	})
+}
+
+// RotateClockwise returns a new grid turned a quarter turn clockwise.
+func (self Grid[T]) RotateClockwise() Grid[T] {
+	return self.remap(self.cols, self.rows, func(p Point) Point {
+		return Point{self.rows - 1 - p.Col, p.Row}
+	})
+}
+
+// RotateAnticlockwise returns a new grid turned a quarter turn anticlockwise.
+func (self Grid[T]) RotateAnticlockwise() Grid[T] {
+	return self.remap(self.cols, self.rows, func(p Point) Point {
+		return Point{p.Col, self.cols - 1 - p.Row}
+	})
+}
+
+// FlipHorizontal returns a new grid mirrored left to right.
+func (self Grid[T]) FlipHorizontal() Grid[T] {
+	return self.remap(self.rows, self.cols, func(p Point) Point {
+		return Point{p.Row, self.cols - 1 - p.Col}
+	})
+}
+
+// FlipVertical returns a new grid mirrored top to bottom.
+func (self Grid[T]) FlipVertical() Grid[T] {
+	return self.remap(self.rows, self.cols, func(p Point) Point {
+		return Point{self.rows - 1 - p.Row, p.Col}
+	})
+}
+
+// String draws the grid one row per line, using the legend it was read with
+// or the render func it was given. Without either, each cell is printed
+// with fmt, so it's only really useful for single-character values.
+func (self Grid[T]) String() string {
+	var b strings.Builder
+	for i := 0; i < self.rows; i++ {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		for _, cell := range self.Row(i) {
+			if self.render != nil {
+				b.WriteRune(self.render(cell))
+			} else {
+				fmt.Fprint(&b, cell)
+			}
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,204 @@
+package lib
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var testLegend = Legend[int]{'.': 0, '#': 1, 'O': 2}
+
+func readTestGrid(t *testing.T, input string) Grid[int] {
+	t.Helper()
+
+	grid, err := ReadGridLegend(NewScanner(1, strings.NewReader(input)), testLegend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return grid
+}
+
+func TestReadGrid(t *testing.T) {
+	grid := readTestGrid(t, "#..\n.O.")
+
+	if grid.Rows() != 2 || grid.Cols() != 3 {
+		t.Fatalf("got %dx%d, want 2x3", grid.Rows(), grid.Cols())
+	}
+
+	if got := grid.String(); got != "#..\n.O." {
+		t.Errorf("String() = %q", got)
+	}
+
+	if got := grid.At(Point{1, 1}); got != 2 {
+		t.Errorf("At(1, 1) = %d, want 2", got)
+	}
+}
+
+func TestReadGridMultibyteLegend(t *testing.T) {
+	legend := Legend[bool]{'█': true, '·': false}
+
+	grid, err := ReadGridLegend(NewScanner(1, strings.NewReader("█·█\n··█")), legend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if grid.Rows() != 2 || grid.Cols() != 3 {
+		t.Fatalf("got %dx%d, want 2x3", grid.Rows(), grid.Cols())
+	}
+	if got := grid.String(); got != "█·█\n··█" {
+		t.Errorf("String() = %q", got)
+	}
+
+	_, err = ReadGridLegend(NewScanner(1, strings.NewReader("█·█\n·x█")), legend)
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Line != 2 || parseErr.Column != 2 {
+		t.Errorf("got %v, want an error at line 2 column 2", err)
+	}
+}
+
+func TestReadGridErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		line   int
+		column int
+	}{
+		{"unknown rune", "#..\n.X.", 2, 2},
+		{"ragged row", "#..\n.O", 2, 0},
+		{"empty row", "\n", 1, 0},
+		{"blank line between rows", "#..\n\n.O.", 2, 0},
+		{"empty", "", 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ReadGridLegend(NewScanner(1, strings.NewReader(test.input)), testLegend)
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("got %v, want a *ParseError", err)
+			}
+			if parseErr.Line != test.line || parseErr.Column != test.column {
+				t.Errorf("got line %d column %d, want line %d column %d",
+					parseErr.Line, parseErr.Column, test.line, test.column)
+			}
+		})
+	}
+}
+
+func TestGridBounds(t *testing.T) {
+	grid := NewGrid[int](2, 3)
+
+	for _, p := range []Point{{-1, 0}, {0, -1}, {2, 0}, {0, 3}} {
+		if _, ok := grid.Get(p); ok {
+			t.Errorf("Get(%v) reported a cell", p)
+		}
+		if grid.Set(p, 1) {
+			t.Errorf("Set(%v) reported success", p)
+		}
+	}
+
+	if !grid.Set(Point{1, 2}, 7) {
+		t.Fatalf("Set on the grid failed")
+	}
+	if got, ok := grid.Get(Point{1, 2}); !ok || got != 7 {
+		t.Errorf("Get(1, 2) = %d %t, want 7 true", got, ok)
+	}
+}
+
+func TestGridViews(t *testing.T) {
+	grid := readTestGrid(t, "#.O\n.O#")
+
+	if got := grid.Row(1); !slices.Equal(got, []int{0, 2, 1}) {
+		t.Errorf("Row(1) = %v", got)
+	}
+	if got := grid.Col(2); !slices.Equal(got, []int{2, 1}) {
+		t.Errorf("Col(2) = %v", got)
+	}
+
+	// Rows write through to the grid, and can't be appended into the next row
+	row := grid.Row(0)
+	row[1] = 1
+	_ = append(row, 9)
+	if grid.String() != "##O\n.O#" {
+		t.Errorf("writing through Row gave\n%s", grid)
+	}
+}
+
+func TestGridNeighbors(t *testing.T) {
+	grid := NewGrid[int](3, 3)
+
+	tests := []struct {
+		p     Point
+		four  int
+		eight int
+		first Point
+	}{
+		{Point{1, 1}, 4, 8, Point{0, 1}},
+		{Point{0, 0}, 2, 3, Point{0, 1}},
+		{Point{2, 1}, 3, 5, Point{1, 1}},
+	}
+
+	for _, test := range tests {
+		four, eight := grid.Neighbors4(test.p), grid.Neighbors8(test.p)
+		if len(four) != test.four || len(eight) != test.eight {
+			t.Errorf("%v: got %d and %d neighbors, want %d and %d",
+				test.p, len(four), len(eight), test.four, test.eight)
+		}
+		if four[0] != test.first {
+			t.Errorf("%v: first neighbor is %v, want %v", test.p, four[0], test.first)
+		}
+	}
+}
+
+func TestGridTransforms(t *testing.T) {
+	grid := readTestGrid(t, "#..\n.O.")
+
+	tests := []struct {
+		name string
+		got  Grid[int]
+		want string
+	}{
+		{"transpose", grid.Transpose(), "#.\n.O\n.."},
+		{"clockwise", grid.RotateClockwise(), ".#\nO.\n.."},
+		{"anticlockwise", grid.RotateAnticlockwise(), "..\n.O\n#."},
+		{"flip horizontal", grid.FlipHorizontal(), "..#\n.O."},
+		{"flip vertical", grid.FlipVertical(), ".O.\n#.."},
+		{"four turns", grid.RotateClockwise().RotateClockwise().RotateClockwise().RotateClockwise(), "#..\n.O."},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.got.String(); got != test.want {
+				t.Errorf("got\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+
+	if grid.String() != "#..\n.O." {
+		t.Errorf("transforms changed the original grid")
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	grid := readTestGrid(t, "O.O\n#O.")
+
+	want := []Point{{0, 0}, {0, 2}, {1, 1}}
+	if got := FindAll(grid, 2); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestGridStringWithoutLegend(t *testing.T) {
+	grid := NewGrid[int](2, 2)
+	grid.Set(Point{0, 1}, 3)
+
+	if got := grid.String(); got != "03\n00" {
+		t.Errorf("got %q", got)
+	}
+
+	render := func(v int) rune { return rune('a' + v) }
+	if got := grid.WithRender(render).String(); got != "ad\naa" {
+		t.Errorf("got %q", got)
+	}
+}
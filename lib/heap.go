@@ -0,0 +1,395 @@
+package lib
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Heap is a binary min-heap, ordered by less.
+type Heap[T any] struct {
+	elements []T
+	less     func(a, b T) bool
+}
+
+func NewHeap[T any](less func(a, b T) bool) Heap[T] {
+	return Heap[T]{
+		elements: make([]T, 0),
+		less:     less,
+	}
+}
+
+// NewHeapFrom heapifies elements in place in O(n), taking ownership of the
+// slice.
+func NewHeapFrom[T any](elements []T, less func(a, b T) bool) Heap[T] {
+	h := Heap[T]{elements, less}
+	for i := len(elements)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	return h
+}
+
+func (self Heap[T]) Len() int {
+	return len(self.elements)
+}
+
+func (self *Heap[T]) Push(val T) {
+	self.elements = append(self.elements, val)
+	self.up(len(self.elements) - 1)
+}
+
+// Peek returns the smallest element without removing it.
+func (self Heap[T]) Peek() T {
+	return self.elements[0]
+}
+
+func (self *Heap[T]) Pop() T {
+	h := self.elements
+	last := len(h) - 1
+	top := h[0]
+	h[0] = h[last]
+	self.elements = h[:last]
+	self.down(0)
+	return top
+}
+
+func (self *Heap[T]) up(i int) {
+	h := self.elements
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !self.less(h[i], h[parent]) {
+			break
+		}
+		h[i], h[parent] = h[parent], h[i]
+		i = parent
+	}
+}
+
+func (self *Heap[T]) down(i int) {
+	h := self.elements
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+		if left < len(h) && self.less(h[left], h[smallest]) {
+			smallest = left
+		}
+		if right < len(h) && self.less(h[right], h[smallest]) {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		h[i], h[smallest] = h[smallest], h[i]
+		i = smallest
+	}
+}
+
+// Entry is a key in an IndexedHeap along with its priority.
+type Entry[K comparable, P any] struct {
+	Key      K
+	Priority P
+}
+
+// DenseIndexedHeap is a min-heap of the ints 0 and up, each at most once,
+// ordered by priority. It tracks where each key sits so a key's priority can
+// be changed or the key removed without searching, which lets searches lower
+// a queued state's cost in place instead of pushing duplicates and skipping
+// the stale ones. Positions are kept in a slice indexed by key, so keys
+// should be small and dense.
+type DenseIndexedHeap[P any] struct {
+	entries  []Entry[int, P]
+	position []int // Where each key is in entries, or -1 if it isn't queued
+	less     func(a, b P) bool
+}
+
+func NewDenseIndexedHeap[P any](less func(a, b P) bool) DenseIndexedHeap[P] {
+	return DenseIndexedHeap[P]{
+		entries: make([]Entry[int, P], 0),
+		less:    less,
+	}
+}
+
+func (self DenseIndexedHeap[P]) Len() int {
+	return len(self.entries)
+}
+
+func (self DenseIndexedHeap[P]) Contains(key int) bool {
+	return key >= 0 && key < len(self.position) && self.position[key] >= 0
+}
+
+// Priority returns the key's current priority, if it's in the heap.
+func (self DenseIndexedHeap[P]) Priority(key int) (P, bool) {
+	if !self.Contains(key) {
+		var zero P
+		return zero, false
+	}
+	return self.entries[self.position[key]].Priority, true
+}
+
+// Push adds key to the heap, or updates its priority if it's already there.
+// It panics if key is negative.
+func (self *DenseIndexedHeap[P]) Push(key int, priority P) {
+	if self.Update(key, priority) {
+		return
+	}
+
+	if key < 0 {
+		panic(fmt.Sprintf("lib.DenseIndexedHeap: negative key %d", key))
+	}
+	self.Grow(key + 1)
+
+	self.entries = append(self.entries, Entry[int, P]{key, priority})
+	self.position[key] = len(self.entries) - 1
+	self.up(len(self.entries) - 1)
+}
+
+// Grow makes room for the keys below n, so pushing them won't have to
+// reallocate. Callers that number keys as they go can grow the heap along
+// with their own per-key slices rather than one key at a time.
+func (self *DenseIndexedHeap[P]) Grow(n int) {
+	if n <= len(self.position) {
+		return
+	}
+
+	self.position = slices.Grow(self.position, n-len(self.position))
+	for len(self.position) < n {
+		self.position = append(self.position, -1)
+	}
+}
+
+// Update changes the priority of a key already in the heap, moving it in
+// whichever direction it needs to go. It reports whether the key was found.
+func (self *DenseIndexedHeap[P]) Update(key int, priority P) bool {
+	if !self.Contains(key) {
+		return false
+	}
+
+	i := self.position[key]
+	self.entries[i].Priority = priority
+	if !self.up(i) {
+		self.down(i)
+	}
+	return true
+}
+
+// DecreaseKey lowers a key's priority, pushing the key if it's not in the
+// heap. It reports whether anything changed, so a priority that isn't an
+// improvement is left alone.
+func (self *DenseIndexedHeap[P]) DecreaseKey(key int, priority P) bool {
+	if !self.Contains(key) {
+		self.Push(key, priority)
+		return true
+	}
+
+	i := self.position[key]
+	if !self.less(priority, self.entries[i].Priority) {
+		return false
+	}
+
+	self.entries[i].Priority = priority
+	self.up(i)
+	return true
+}
+
+// Peek returns the entry with the smallest priority without removing it.
+func (self DenseIndexedHeap[P]) Peek() Entry[int, P] {
+	return self.entries[0]
+}
+
+func (self *DenseIndexedHeap[P]) Pop() Entry[int, P] {
+	top := self.entries[0]
+	self.removeAt(0)
+	return top
+}
+
+// Remove takes key out of the heap, returning its priority if it was there.
+func (self *DenseIndexedHeap[P]) Remove(key int) (P, bool) {
+	if !self.Contains(key) {
+		var zero P
+		return zero, false
+	}
+
+	i := self.position[key]
+	priority := self.entries[i].Priority
+	self.removeAt(i)
+	return priority, true
+}
+
+func (self *DenseIndexedHeap[P]) removeAt(i int) {
+	last := len(self.entries) - 1
+	self.position[self.entries[i].Key] = -1
+
+	if i != last {
+		self.place(i, self.entries[last])
+	}
+	self.entries = self.entries[:last]
+
+	// The entry moved into the hole can belong above or below it
+	if i < last && !self.up(i) {
+		self.down(i)
+	}
+}
+
+// Sifting moves the entry through a hole rather than swapping, so each level
+// costs one position update instead of two.
+func (self *DenseIndexedHeap[P]) place(i int, entry Entry[int, P]) {
+	self.entries[i] = entry
+	self.position[entry.Key] = i
+}
+
+// Reports whether the entry moved.
+func (self *DenseIndexedHeap[P]) up(i int) bool {
+	h := self.entries
+	entry, start := h[i], i
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !self.less(entry.Priority, h[parent].Priority) {
+			break
+		}
+		self.place(i, h[parent])
+		i = parent
+	}
+
+	if i == start {
+		return false
+	}
+	self.place(i, entry)
+	return true
+}
+
+func (self *DenseIndexedHeap[P]) down(i int) {
+	h := self.entries
+	entry, start := h[i], i
+	for {
+		child := 2*i + 1
+		if child >= len(h) {
+			break
+		}
+		if right := child + 1; right < len(h) && self.less(h[right].Priority, h[child].Priority) {
+			child = right
+		}
+		if !self.less(h[child].Priority, entry.Priority) {
+			break
+		}
+		self.place(i, h[child])
+		i = child
+	}
+
+	if i != start {
+		self.place(i, entry)
+	}
+}
+
+// IndexedHeap is a DenseIndexedHeap over any comparable keys, built by
+// numbering keys as they're first pushed. A key keeps its number after it
+// leaves the heap, so memory grows with the number of distinct keys ever
+// pushed rather than the number queued, which suits searches that remember
+// every state anyway.
+type IndexedHeap[K comparable, P any] struct {
+	ids   map[K]int
+	keys  []K
+	dense DenseIndexedHeap[P]
+}
+
+func NewIndexedHeap[K comparable, P any](less func(a, b P) bool) IndexedHeap[K, P] {
+	return IndexedHeap[K, P]{
+		ids:   make(map[K]int),
+		dense: NewDenseIndexedHeap(less),
+	}
+}
+
+// NewIndexedHeapFrom heapifies entries in O(n). Keys must be unique.
+func NewIndexedHeapFrom[K comparable, P any](entries []Entry[K, P], less func(a, b P) bool) IndexedHeap[K, P] {
+	h := IndexedHeap[K, P]{
+		ids:  make(map[K]int, len(entries)),
+		keys: make([]K, len(entries)),
+		dense: DenseIndexedHeap[P]{
+			entries:  make([]Entry[int, P], len(entries)),
+			position: make([]int, len(entries)),
+			less:     less,
+		},
+	}
+
+	for i, entry := range entries {
+		if _, exists := h.ids[entry.Key]; exists {
+			panic(fmt.Sprintf("duplicate heap key %v", entry.Key))
+		}
+		h.ids[entry.Key] = i
+		h.keys[i] = entry.Key
+		h.dense.entries[i] = Entry[int, P]{i, entry.Priority}
+		h.dense.position[i] = i
+	}
+
+	for i := len(entries)/2 - 1; i >= 0; i-- {
+		h.dense.down(i)
+	}
+	return h
+}
+
+func (self IndexedHeap[K, P]) Len() int {
+	return self.dense.Len()
+}
+
+func (self IndexedHeap[K, P]) Contains(key K) bool {
+	id, known := self.ids[key]
+	return known && self.dense.Contains(id)
+}
+
+// Priority returns the key's current priority, if it's in the heap.
+func (self IndexedHeap[K, P]) Priority(key K) (P, bool) {
+	id, known := self.ids[key]
+	if !known {
+		var zero P
+		return zero, false
+	}
+	return self.dense.Priority(id)
+}
+
+// Push adds key to the heap, or updates its priority if it's already there.
+func (self *IndexedHeap[K, P]) Push(key K, priority P) {
+	self.dense.Push(self.id(key), priority)
+}
+
+// Update changes the priority of a key already in the heap, moving it in
+// whichever direction it needs to go. It reports whether the key was found.
+func (self *IndexedHeap[K, P]) Update(key K, priority P) bool {
+	id, known := self.ids[key]
+	return known && self.dense.Update(id, priority)
+}
+
+// DecreaseKey lowers a key's priority, pushing the key if it's not in the
+// heap. It reports whether anything changed, so a priority that isn't an
+// improvement is left alone.
+func (self *IndexedHeap[K, P]) DecreaseKey(key K, priority P) bool {
+	return self.dense.DecreaseKey(self.id(key), priority)
+}
+
+// Peek returns the entry with the smallest priority without removing it.
+func (self IndexedHeap[K, P]) Peek() Entry[K, P] {
+	top := self.dense.Peek()
+	return Entry[K, P]{self.keys[top.Key], top.Priority}
+}
+
+func (self *IndexedHeap[K, P]) Pop() Entry[K, P] {
+	top := self.dense.Pop()
+	return Entry[K, P]{self.keys[top.Key], top.Priority}
+}
+
+// Remove takes key out of the heap, returning its priority if it was there.
+func (self *IndexedHeap[K, P]) Remove(key K) (P, bool) {
+	id, known := self.ids[key]
+	if !known {
+		var zero P
+		return zero, false
+	}
+	return self.dense.Remove(id)
+}
+
+func (self *IndexedHeap[K, P]) id(key K) int {
+	id, ok := self.ids[key]
+	if !ok {
+		id = len(self.keys)
+		self.ids[key] = id
+		self.keys = append(self.keys, key)
+	}
+	return id
+}
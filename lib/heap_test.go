@@ -0,0 +1,300 @@
+package lib
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func intLess(a, b int) bool { return a < b }
+
+func TestHeapPopsInOrder(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	values := make([]int, 200)
+	for i := range values {
+		values[i] = rng.Intn(50) // plenty of duplicates
+	}
+
+	heap := NewHeap(intLess)
+	for _, v := range values {
+		heap.Push(v)
+	}
+
+	want := slices.Clone(values)
+	slices.Sort(want)
+
+	for i, v := range want {
+		if peeked := heap.Peek(); peeked != v {
+			t.Fatalf("peek %d: got %d, want %d", i, peeked, v)
+		}
+		if popped := heap.Pop(); popped != v {
+			t.Fatalf("pop %d: got %d, want %d", i, popped, v)
+		}
+	}
+
+	if heap.Len() != 0 {
+		t.Errorf("got %d elements left, want 0", heap.Len())
+	}
+}
+
+func TestNewHeapFrom(t *testing.T) {
+	for size := 0; size < 20; size++ {
+		values := make([]int, size)
+		for i := range values {
+			values[i] = size - i
+		}
+
+		heap := NewHeapFrom(slices.Clone(values), intLess)
+		slices.Sort(values)
+
+		for _, v := range values {
+			if got := heap.Pop(); got != v {
+				t.Fatalf("size %d: got %d, want %d", size, got, v)
+			}
+		}
+	}
+}
+
+func TestHeapComparator(t *testing.T) {
+	type job struct {
+		name     string
+		priority int
+	}
+
+	// A max-heap, with ties broken by name
+	heap := NewHeap(func(a, b job) bool {
+		if a.priority != b.priority {
+			return a.priority > b.priority
+		}
+		return a.name < b.name
+	})
+	heap.Push(job{"b", 1})
+	heap.Push(job{"c", 3})
+	heap.Push(job{"a", 1})
+	heap.Push(job{"d", 2})
+
+	var got []string
+	for heap.Len() > 0 {
+		got = append(got, heap.Pop().name)
+	}
+
+	if want := []string{"c", "d", "a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// Checks the heap property and that every key's position points at its
+// entry.
+func checkIndexedHeap(t *testing.T, heap IndexedHeap[string, int]) {
+	t.Helper()
+	dense := heap.dense
+
+	queued := 0
+	for _, i := range dense.position {
+		if i >= 0 {
+			queued++
+		}
+	}
+	if queued != len(dense.entries) {
+		t.Fatalf("%d keys have positions, heap has %d entries", queued, len(dense.entries))
+	}
+
+	for i, entry := range dense.entries {
+		if dense.position[entry.Key] != i {
+			t.Fatalf("key %q is at %d, position says %d", heap.keys[entry.Key], i, dense.position[entry.Key])
+		}
+		if i > 0 && dense.less(entry.Priority, dense.entries[(i-1)/2].Priority) {
+			t.Fatalf("entry %d is smaller than its parent", i)
+		}
+	}
+}
+
+func TestIndexedHeap(t *testing.T) {
+	heap := NewIndexedHeap[string](intLess)
+	heap.Push("a", 5)
+	heap.Push("b", 3)
+	heap.Push("c", 8)
+	heap.Push("d", 1)
+	checkIndexedHeap(t, heap)
+
+	if top := heap.Peek(); top.Key != "d" || top.Priority != 1 {
+		t.Errorf("peek: got %v, want {d 1}", top)
+	}
+
+	if heap.DecreaseKey("c", 9) {
+		t.Errorf("DecreaseKey to a higher priority reported a change")
+	}
+	if !heap.DecreaseKey("c", 0) {
+		t.Errorf("DecreaseKey to a lower priority reported no change")
+	}
+	checkIndexedHeap(t, heap)
+
+	if !heap.Update("d", 10) {
+		t.Errorf("Update of a queued key reported it missing")
+	}
+	if heap.Update("z", 10) {
+		t.Errorf("Update of a missing key reported it found")
+	}
+	checkIndexedHeap(t, heap)
+
+	if priority, ok := heap.Remove("b"); !ok || priority != 3 {
+		t.Errorf("Remove: got %d %t, want 3 true", priority, ok)
+	}
+	if _, ok := heap.Remove("b"); ok {
+		t.Errorf("Remove of a removed key reported it found")
+	}
+	checkIndexedHeap(t, heap)
+
+	var got []Entry[string, int]
+	for heap.Len() > 0 {
+		got = append(got, heap.Pop())
+		checkIndexedHeap(t, heap)
+	}
+
+	want := []Entry[string, int]{{"c", 0}, {"a", 5}, {"d", 10}}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if heap.Contains("a") {
+		t.Errorf("popped key is still in the heap")
+	}
+}
+
+// Runs random operations against the heap and a plain map, checking the sift
+// logic keeps the two in agreement.
+func TestIndexedHeapRandomOperations(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	keys := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"}
+
+	heap := NewIndexedHeap[string](intLess)
+	model := make(map[string]int)
+
+	for i := 0; i < 5000; i++ {
+		key, priority := keys[rng.Intn(len(keys))], rng.Intn(100)
+
+		switch rng.Intn(5) {
+		case 0:
+			heap.Push(key, priority)
+			model[key] = priority
+		case 1:
+			_, queued := model[key]
+			if heap.Update(key, priority) != queued {
+				t.Fatalf("Update(%q) disagreed about whether the key was queued", key)
+			}
+			if queued {
+				model[key] = priority
+			}
+		case 2:
+			old, queued := model[key]
+			if changed := heap.DecreaseKey(key, priority); changed != (!queued || priority < old) {
+				t.Fatalf("DecreaseKey(%q, %d) from %d reported changed = %t", key, priority, old, changed)
+			}
+			if !queued || priority < old {
+				model[key] = priority
+			}
+		case 3:
+			got, ok := heap.Remove(key)
+			want, queued := model[key]
+			if ok != queued || got != want {
+				t.Fatalf("Remove(%q): got %d %t, want %d %t", key, got, ok, want, queued)
+			}
+			delete(model, key)
+		case 4:
+			if len(model) == 0 {
+				continue
+			}
+			top := heap.Pop()
+			for _, p := range model {
+				if p < top.Priority {
+					t.Fatalf("popped %v while %d was queued", top, p)
+				}
+			}
+			if model[top.Key] != top.Priority {
+				t.Fatalf("popped %v, model has priority %d", top, model[top.Key])
+			}
+			delete(model, top.Key)
+		}
+
+		checkIndexedHeap(t, heap)
+		for key, want := range model {
+			if got, _ := heap.Priority(key); got != want {
+				t.Fatalf("priority of %q: got %d, want %d", key, got, want)
+			}
+		}
+	}
+}
+
+func TestDenseIndexedHeap(t *testing.T) {
+	heap := NewDenseIndexedHeap(intLess)
+	heap.Push(40, 3)
+	heap.Push(2, 5)
+	heap.DecreaseKey(7, 4)
+
+	if heap.Contains(3) || heap.Contains(100) || heap.Contains(-1) {
+		t.Error("got keys that were never pushed")
+	}
+
+	var got []int
+	for heap.Len() > 0 {
+		got = append(got, heap.Pop().Key)
+	}
+	if want := []int{40, 7, 2}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// Keys can come back after they've left
+	heap.Push(40, 1)
+	if priority, ok := heap.Priority(40); !ok || priority != 1 {
+		t.Errorf("got priority %d %t, want 1 true", priority, ok)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("a negative key didn't panic")
+		}
+	}()
+	heap.Push(-1, 0)
+}
+
+func TestDenseIndexedHeapGrow(t *testing.T) {
+	heap := NewDenseIndexedHeap(intLess)
+	heap.Grow(100)
+
+	if heap.Len() != 0 || heap.Contains(0) || heap.Contains(99) {
+		t.Fatal("growing the heap queued keys")
+	}
+
+	position := &heap.position[0]
+	for key := 99; key >= 0; key-- {
+		heap.Push(key, key)
+	}
+	if &heap.position[0] != position {
+		t.Error("pushing keys below the grown size reallocated")
+	}
+	if got := heap.Pop().Key; got != 0 {
+		t.Errorf("got %d, want 0", got)
+	}
+}
+
+func TestNewIndexedHeapFrom(t *testing.T) {
+	entries := []Entry[string, int]{{"a", 4}, {"b", 2}, {"c", 9}, {"d", 1}, {"e", 7}}
+	heap := NewIndexedHeapFrom(slices.Clone(entries), intLess)
+	checkIndexedHeap(t, heap)
+
+	var got []string
+	for heap.Len() > 0 {
+		got = append(got, heap.Pop().Key)
+	}
+
+	if want := []string{"d", "b", "a", "e", "c"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("duplicate keys didn't panic")
+		}
+	}()
+	NewIndexedHeapFrom([]Entry[string, int]{{"a", 1}, {"a", 2}}, intLess)
+}
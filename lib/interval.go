@@ -0,0 +1,225 @@
+package lib
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// Range is the half-open range of ints [Start, End).
+type Range struct {
+	Start int
+	End   int
+}
+
+func (self Range) Len() int {
+	return max(self.End-self.Start, 0)
+}
+
+func (self Range) Empty() bool {
+	return self.End <= self.Start
+}
+
+func (self Range) Contains(n int) bool {
+	return self.Start <= n && n < self.End
+}
+
+// IntervalSet is a set of ints stored as sorted ranges that neither overlap
+// nor touch, so sets with the same members always have the same ranges.
+type IntervalSet struct {
+	ranges []Range
+}
+
+// NewIntervalSet returns the set covering every one of ranges. They can be
+// in any order and may overlap.
+func NewIntervalSet(ranges ...Range) IntervalSet {
+	sorted := make([]Range, 0, len(ranges))
+	for _, r := range ranges {
+		if !r.Empty() {
+			sorted = append(sorted, r)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b Range) int { return a.Start - b.Start })
+
+	merged := make([]Range, 0, len(sorted))
+	for _, r := range sorted {
+		if last := len(merged) - 1; last >= 0 && r.Start <= merged[last].End {
+			merged[last].End = max(merged[last].End, r.End)
+		} else {
+			merged = append(merged, r)
+		}
+	}
+
+	return IntervalSet{merged}
+}
+
+// Ranges returns the set's ranges in ascending order.
+func (self IntervalSet) Ranges() []Range {
+	return slices.Clone(self.ranges)
+}
+
+// Len is the number of ints in the set.
+func (self IntervalSet) Len() int {
+	total := 0
+	for _, r := range self.ranges {
+		total += r.Len()
+	}
+	return total
+}
+
+func (self IntervalSet) Empty() bool {
+	return len(self.ranges) == 0
+}
+
+func (self IntervalSet) Contains(n int) bool {
+	i := sort.Search(len(self.ranges), func(i int) bool { return self.ranges[i].End > n })
+	return i < len(self.ranges) && self.ranges[i].Contains(n)
+}
+
+// Min returns the smallest int in the set, or reports false if it's empty.
+func (self IntervalSet) Min() (int, bool) {
+	if self.Empty() {
+		return 0, false
+	}
+	return self.ranges[0].Start, true
+}
+
+func (self IntervalSet) Equal(other IntervalSet) bool {
+	return slices.Equal(self.ranges, other.ranges)
+}
+
+func (self IntervalSet) Union(other IntervalSet) IntervalSet {
+	return NewIntervalSet(append(slices.Clone(self.ranges), other.ranges...)...)
+}
+
+func (self IntervalSet) Intersect(other IntervalSet) IntervalSet {
+	a, b := self.ranges, other.ranges
+	intersection := make([]Range, 0)
+
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		overlap := Range{max(a[i].Start, b[j].Start), min(a[i].End, b[j].End)}
+		if !overlap.Empty() {
+			intersection = append(intersection, overlap)
+		}
+
+		// Whichever range ends first can't overlap anything else
+		if a[i].End < b[j].End {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return IntervalSet{intersection}
+}
+
+// Subtract returns the ints in self that aren't in other.
+func (self IntervalSet) Subtract(other IntervalSet) IntervalSet {
+	difference := make([]Range, 0)
+	j := 0
+
+	for _, r := range self.ranges {
+		// Skip the ranges of other that end before this one starts
+		for j < len(other.ranges) && other.ranges[j].End <= r.Start {
+			j++
+		}
+
+		start := r.Start
+		for k := j; k < len(other.ranges) && other.ranges[k].Start < r.End; k++ {
+			if start < other.ranges[k].Start {
+				difference = append(difference, Range{start, other.ranges[k].Start})
+			}
+			start = max(start, other.ranges[k].End)
+		}
+
+		if start < r.End {
+			difference = append(difference, Range{start, r.End})
+		}
+	}
+
+	return IntervalSet{difference}
+}
+
+// Shift returns the set with offset added to every member.
+func (self IntervalSet) Shift(offset int) IntervalSet {
+	shifted := make([]Range, len(self.ranges))
+	for i, r := range self.ranges {
+		shifted[i] = Range{r.Start + offset, r.End + offset}
+	}
+	return IntervalSet{shifted}
+}
+
+// Segment moves every int in Source by Offset.
+type Segment struct {
+	Source Range
+	Offset int
+}
+
+// OffsetMap is a piecewise function that adds a segment's offset to the ints
+// in its source range, and leaves ints outside every segment alone.
+type OffsetMap struct {
+	segments []Segment
+}
+
+// NewOffsetMap builds a map from segments in any order, which mustn't
+// overlap.
+func NewOffsetMap(segments ...Segment) (OffsetMap, error) {
+	sorted := make([]Segment, 0, len(segments))
+	for _, segment := range segments {
+		if !segment.Source.Empty() {
+			sorted = append(sorted, segment)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b Segment) int { return a.Source.Start - b.Source.Start })
+
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Source.Start < sorted[i-1].Source.End {
+			return OffsetMap{}, fmt.Errorf("segments [%d, %d) and [%d, %d) overlap",
+				sorted[i-1].Source.Start, sorted[i-1].Source.End, sorted[i].Source.Start, sorted[i].Source.End)
+		}
+	}
+
+	return OffsetMap{sorted}, nil
+}
+
+// The index of the first segment that ends after n.
+func (self OffsetMap) search(n int) int {
+	return sort.Search(len(self.segments), func(i int) bool { return self.segments[i].Source.End > n })
+}
+
+func (self OffsetMap) Map(n int) int {
+	if i := self.search(n); i < len(self.segments) && self.segments[i].Source.Contains(n) {
+		return n + self.segments[i].Offset
+	}
+	return n
+}
+
+// MapSet maps every member of set, splitting its ranges wherever they cross
+// from one segment to another.
+func (self OffsetMap) MapSet(set IntervalSet) IntervalSet {
+	mapped := make([]Range, 0, len(set.ranges))
+
+	for _, r := range set.ranges {
+		start := r.Start
+
+		for i := self.search(r.Start); i < len(self.segments) && self.segments[i].Source.Start < r.End; i++ {
+			segment := self.segments[i]
+
+			// The gap before this segment maps to itself
+			if start < segment.Source.Start {
+				mapped = append(mapped, Range{start, segment.Source.Start})
+				start = segment.Source.Start
+			}
+
+			end := min(r.End, segment.Source.End)
+			mapped = append(mapped, Range{start + segment.Offset, end + segment.Offset})
+			start = end
+		}
+
+		if start < r.End {
+			mapped = append(mapped, Range{start, r.End})
+		}
+	}
+
+	return NewIntervalSet(mapped...)
+}
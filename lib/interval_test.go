@@ -0,0 +1,185 @@
+package lib
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestNewIntervalSetNormalizes(t *testing.T) {
+	set := NewIntervalSet(Range{10, 12}, Range{0, 3}, Range{2, 5}, Range{5, 7}, Range{20, 20}, Range{9, 1})
+
+	want := []Range{{0, 7}, {10, 12}}
+	if got := set.Ranges(); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if set.Len() != 9 {
+		t.Errorf("got length %d, want 9", set.Len())
+	}
+	if min, _ := set.Min(); min != 0 {
+		t.Errorf("got min %d, want 0", min)
+	}
+
+	for n, want := range map[int]bool{-1: false, 0: true, 6: true, 7: false, 10: true, 12: false} {
+		if got := set.Contains(n); got != want {
+			t.Errorf("Contains(%d) = %t, want %t", n, got, want)
+		}
+	}
+}
+
+func TestIntervalSetOperations(t *testing.T) {
+	a := NewIntervalSet(Range{0, 10}, Range{20, 30})
+	b := NewIntervalSet(Range{5, 25}, Range{28, 40})
+
+	tests := []struct {
+		name string
+		got  IntervalSet
+		want []Range
+	}{
+		{"union", a.Union(b), []Range{{0, 40}}},
+		{"intersect", a.Intersect(b), []Range{{5, 10}, {20, 25}, {28, 30}}},
+		{"subtract", a.Subtract(b), []Range{{0, 5}, {25, 28}}},
+		{"reverse subtract", b.Subtract(a), []Range{{10, 20}, {30, 40}}},
+		{"subtract everything", a.Subtract(NewIntervalSet(Range{-5, 50})), []Range{}},
+		{"shift", a.Shift(-5), []Range{{-5, 5}, {15, 25}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.got.Ranges(); !slices.Equal(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+// Builds random sets over a small domain and checks the operations against
+// plain sets of ints.
+func TestIntervalSetRandomOperations(t *testing.T) {
+	rng := rand.New(rand.NewSource(17))
+
+	randomSet := func() (IntervalSet, Set[int]) {
+		ranges := make([]Range, rng.Intn(5))
+		members := NewSet[int]()
+		for i := range ranges {
+			start := rng.Intn(40)
+			ranges[i] = Range{start, start + rng.Intn(10)}
+			for n := ranges[i].Start; n < ranges[i].End; n++ {
+				members.Add(n)
+			}
+		}
+		return NewIntervalSet(ranges...), members
+	}
+
+	toSet := func(set IntervalSet) Set[int] {
+		members := NewSet[int]()
+		for _, r := range set.Ranges() {
+			for n := r.Start; n < r.End; n++ {
+				members.Add(n)
+			}
+		}
+		return members
+	}
+
+	for i := 0; i < 500; i++ {
+		a, aMembers := randomSet()
+		b, bMembers := randomSet()
+
+		checks := []struct {
+			name string
+			got  IntervalSet
+			want Set[int]
+		}{
+			{"union", a.Union(b), aMembers.Union(bMembers)},
+			{"intersect", a.Intersect(b), aMembers.Intersection(bMembers)},
+			{"subtract", a.Subtract(b), aMembers.Difference(bMembers)},
+		}
+
+		for _, check := range checks {
+			if !toSet(check.got).Equal(check.want) {
+				t.Fatalf("%v %s %v: got %v, want %v", a.Ranges(), check.name, b.Ranges(), check.got.Ranges(), Sorted(check.want))
+			}
+
+			// The result should already be normalized
+			if renormalized := NewIntervalSet(check.got.Ranges()...); !renormalized.Equal(check.got) {
+				t.Fatalf("%s gave unnormalized ranges %v", check.name, check.got.Ranges())
+			}
+		}
+	}
+}
+
+func TestOffsetMap(t *testing.T) {
+	// The seed-to-soil map from day 5's example
+	m, err := NewOffsetMap(Segment{Range{98, 100}, -48}, Segment{Range{50, 98}, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for n, want := range map[int]int{0: 0, 49: 49, 50: 52, 97: 99, 98: 50, 99: 51, 100: 100} {
+		if got := m.Map(n); got != want {
+			t.Errorf("Map(%d) = %d, want %d", n, got, want)
+		}
+	}
+
+	// Seeds 79-92 and 55-67 fall inside one segment, so they just shift
+	seeds := NewIntervalSet(Range{79, 93}, Range{55, 68})
+	want := []Range{{57, 70}, {81, 95}}
+	if got := m.MapSet(seeds).Ranges(); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// A range spanning both segments and the gaps around them is split at
+	// each edge, but here the pieces land back together
+	everything := NewIntervalSet(Range{40, 110})
+	want = []Range{{40, 110}}
+	if got := m.MapSet(everything).Ranges(); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestOffsetMapMatchesPointwise(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+
+	for i := 0; i < 200; i++ {
+		// Lay segments end to end with random gaps so they never overlap
+		segments := make([]Segment, 0)
+		for start := rng.Intn(5); start < 60; {
+			length := 1 + rng.Intn(8)
+			segments = append(segments, Segment{Range{start, start + length}, rng.Intn(41) - 20})
+			start += length + rng.Intn(4)
+		}
+		rng.Shuffle(len(segments), func(i, j int) { segments[i], segments[j] = segments[j], segments[i] })
+
+		m, err := NewOffsetMap(segments...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		start := rng.Intn(50)
+		input := NewIntervalSet(Range{start, start + rng.Intn(30)})
+
+		want := NewSet[int]()
+		for _, r := range input.Ranges() {
+			for n := r.Start; n < r.End; n++ {
+				want.Add(m.Map(n))
+			}
+		}
+
+		got := NewSet[int]()
+		for _, r := range m.MapSet(input).Ranges() {
+			for n := r.Start; n < r.End; n++ {
+				got.Add(n)
+			}
+		}
+
+		if !got.Equal(want) {
+			t.Fatalf("mapping %v: got %v, want %v", input.Ranges(), Sorted(got), Sorted(want))
+		}
+	}
+}
+
+func TestNewOffsetMapRejectsOverlap(t *testing.T) {
+	if _, err := NewOffsetMap(Segment{Range{0, 10}, 1}, Segment{Range{9, 12}, 2}); err == nil {
+		t.Errorf("overlapping segments were accepted")
+	}
+}
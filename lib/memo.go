@@ -0,0 +1,178 @@
+package lib
+
+import "fmt"
+
+// Memo caches a recursive function. The function is handed a recurse func
+// to call instead of itself, so every call, including the inner ones, goes
+// through the cache and there's no store to forget on the way out.
+//
+//	fib := NewMemo(func(fib func(int) int, n int) int {
+//		if n < 2 {
+//			return n
+//		}
+//		return fib(n-1) + fib(n-2)
+//	})
+//	fib.Get(90)
+type Memo[K any, V any] struct {
+	fn      func(recurse func(K) V, key K) V
+	recurse func(K) V
+	store   memoStore[K, V]
+	stats   MemoStats
+}
+
+// MemoStats counts how a Memo's cache has been used. Evictions only happen
+// in a memo with a size bound.
+type MemoStats struct {
+	Hits, Misses, Evictions int
+}
+
+// HitRate is the fraction of lookups answered from the cache.
+func (self MemoStats) HitRate() float64 {
+	if self.Hits+self.Misses == 0 {
+		return 0
+	}
+	return float64(self.Hits) / float64(self.Hits+self.Misses)
+}
+
+type memoStore[K any, V any] interface {
+	get(key K) (V, bool)
+	put(key K, val V) (evicted bool)
+	len() int
+	reset()
+}
+
+// NewMemo caches every result in a map.
+func NewMemo[K comparable, V any](fn func(recurse func(K) V, key K) V) *Memo[K, V] {
+	return newMemo[K, V](&mapStore[K, V]{cache: make(map[K]V)}, fn)
+}
+
+// NewBoundedMemo keeps at most limit results, forgetting the oldest ones
+// first. Forgotten results are worked out again if they're needed.
+func NewBoundedMemo[K comparable, V any](limit int, fn func(recurse func(K) V, key K) V) *Memo[K, V] {
+	if limit <= 0 {
+		panic(fmt.Sprintf("lib.NewBoundedMemo with limit %d", limit))
+	}
+	return newMemo[K, V](&mapStore[K, V]{cache: make(map[K]V), limit: limit}, fn)
+}
+
+// NewDenseMemo caches results in a slice of size slots, for keys that index
+// packs into [0, size). It's much faster than a map when most of the key
+// space gets used, as in a dynamic programming table. Keys that index
+// outside the slice panic.
+func NewDenseMemo[K any, V any](size int, index func(K) int, fn func(recurse func(K) V, key K) V) *Memo[K, V] {
+	return newMemo[K, V](&denseStore[K, V]{index: index, values: make([]V, size), filled: make([]bool, size)}, fn)
+}
+
+func newMemo[K any, V any](store memoStore[K, V], fn func(recurse func(K) V, key K) V) *Memo[K, V] {
+	memo := &Memo[K, V]{fn: fn, store: store}
+	memo.recurse = memo.Get
+	return memo
+}
+
+// Get returns fn's result for key, working it out only if it isn't cached.
+func (self *Memo[K, V]) Get(key K) V {
+	if val, ok := self.store.get(key); ok {
+		self.stats.Hits++
+		return val
+	}
+
+	self.stats.Misses++
+	val := self.fn(self.recurse, key)
+	if self.store.put(key, val) {
+		self.stats.Evictions++
+	}
+	return val
+}
+
+func (self *Memo[K, V]) Stats() MemoStats {
+	return self.stats
+}
+
+// Len is the number of results currently cached.
+func (self *Memo[K, V]) Len() int {
+	return self.store.len()
+}
+
+// Reset empties the cache and zeroes the stats.
+func (self *Memo[K, V]) Reset() {
+	self.store.reset()
+	self.stats = MemoStats{}
+}
+
+type mapStore[K comparable, V any] struct {
+	cache map[K]V
+	limit int      // 0 means no limit
+	order Deque[K] // Insertion order, only kept when there's a limit
+}
+
+func (self *mapStore[K, V]) get(key K) (V, bool) {
+	val, ok := self.cache[key]
+	return val, ok
+}
+
+func (self *mapStore[K, V]) put(key K, val V) bool {
+	if _, ok := self.cache[key]; ok || self.limit == 0 {
+		self.cache[key] = val
+		return false
+	}
+
+	evicted := false
+	if len(self.cache) == self.limit {
+		oldest, _ := self.order.PopFront()
+		delete(self.cache, oldest)
+		evicted = true
+	}
+
+	self.cache[key] = val
+	self.order.PushBack(key)
+	return evicted
+}
+
+func (self *mapStore[K, V]) len() int {
+	return len(self.cache)
+}
+
+func (self *mapStore[K, V]) reset() {
+	clear(self.cache)
+	self.order = Deque[K]{}
+}
+
+type denseStore[K any, V any] struct {
+	index  func(K) int
+	values []V
+	filled []bool
+	count  int
+}
+
+func (self *denseStore[K, V]) slot(key K) int {
+	i := self.index(key)
+	if i < 0 || i >= len(self.values) {
+		panic(fmt.Sprintf("lib.Memo: key %v indexes slot %d of %d", key, i, len(self.values)))
+	}
+	return i
+}
+
+func (self *denseStore[K, V]) get(key K) (V, bool) {
+	i := self.slot(key)
+	return self.values[i], self.filled[i]
+}
+
+func (self *denseStore[K, V]) put(key K, val V) bool {
+	i := self.slot(key)
+	if !self.filled[i] {
+		self.filled[i] = true
+		self.count++
+	}
+	self.values[i] = val
+	return false
+}
+
+func (self *denseStore[K, V]) len() int {
+	return self.count
+}
+
+func (self *denseStore[K, V]) reset() {
+	clear(self.values)
+	clear(self.filled)
+	self.count = 0
+}
@@ -0,0 +1,100 @@
+package lib
+
+import "testing"
+
+func fibonacci(fib func(int) int, n int) int {
+	if n < 2 {
+		return n
+	}
+	return fib(n-1) + fib(n-2)
+}
+
+func TestMemo(t *testing.T) {
+	memo := NewMemo(fibonacci)
+
+	if got := memo.Get(90); got != 2880067194370816120 {
+		t.Errorf("got %d, want 2880067194370816120", got)
+	}
+
+	// Each n from 0 to 90 is worked out once, and every n from 1 to 88 is
+	// asked for a second time by n+2
+	want := MemoStats{Hits: 88, Misses: 91}
+	if got := memo.Stats(); got != want || memo.Len() != 91 {
+		t.Errorf("got %+v with %d cached, want %+v with 91 cached", got, memo.Len(), want)
+	}
+
+	memo.Get(90)
+	if got := memo.Stats(); got.Hits != 89 || got.Misses != 91 {
+		t.Errorf("a repeated lookup gave %+v", got)
+	}
+
+	memo.Reset()
+	if memo.Len() != 0 || memo.Stats() != (MemoStats{}) {
+		t.Errorf("Reset left %d cached and %+v", memo.Len(), memo.Stats())
+	}
+}
+
+func TestBoundedMemo(t *testing.T) {
+	calls := 0
+	memo := NewBoundedMemo(3, func(fib func(int) int, n int) int {
+		calls++
+		return fibonacci(fib, n)
+	})
+
+	if got := memo.Get(40); got != 102334155 {
+		t.Errorf("got %d, want 102334155", got)
+	}
+	if memo.Len() != 3 {
+		t.Errorf("got %d cached, want at most 3", memo.Len())
+	}
+
+	stats := memo.Stats()
+	if stats.Misses != calls || stats.Evictions != calls-3 {
+		t.Errorf("got %+v after %d calls", stats, calls)
+	}
+
+	// The most recent results are the ones kept
+	memo.Get(40)
+	if memo.Stats().Hits != stats.Hits+1 {
+		t.Errorf("40 wasn't kept")
+	}
+	memo.Get(0)
+	if memo.Stats().Misses != stats.Misses+1 {
+		t.Errorf("0 wasn't forgotten")
+	}
+}
+
+func TestDenseMemo(t *testing.T) {
+	// Lattice paths through a grid, keyed by point
+	const size = 17
+	index := func(p Point) int { return p.Row*size + p.Col }
+
+	memo := NewDenseMemo(size*size, index, func(paths func(Point) int, p Point) int {
+		if p.Row == 0 || p.Col == 0 {
+			return 1
+		}
+		return paths(Point{p.Row - 1, p.Col}) + paths(Point{p.Row, p.Col - 1})
+	})
+
+	if got := memo.Get(Point{16, 16}); got != 601080390 {
+		t.Errorf("got %d, want 601080390", got)
+	}
+	if memo.Len() != size*size-1 {
+		t.Errorf("got %d cached, want %d", memo.Len(), size*size-1)
+	}
+	if rate := memo.Stats().HitRate(); rate < 0.4 || rate > 0.6 {
+		t.Errorf("got a hit rate of %f", rate)
+	}
+
+	memo.Reset()
+	if memo.Len() != 0 {
+		t.Errorf("Reset left %d cached", memo.Len())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("a key outside the table didn't panic")
+		}
+	}()
+	memo.Get(Point{size, 0})
+}
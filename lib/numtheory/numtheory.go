@@ -0,0 +1,254 @@
+// Package numtheory has the number theory puzzles keep reaching for: gcd and
+// lcm, modular arithmetic and the Chinese remainder theorem.
+//
+// Anything whose result can outgrow an int reports ErrOverflow rather than
+// silently wrapping, and has a Big counterpart in math/big to fall back on.
+// Modular products are computed exactly, so ModPow and friends never
+// overflow.
+package numtheory
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+var (
+	ErrOverflow      = errors.New("result overflows int")
+	ErrNotInvertible = errors.New("not invertible")
+	ErrNoSolution    = errors.New("no solution")
+)
+
+// The magnitude of n, which unlike -n is fine for math.MinInt.
+func magnitude(n int) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+	return uint64(n)
+}
+
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// GCD is the greatest common divisor of a and b, which is never negative.
+// GCD(0, 0) is 0. It panics if the answer is 2^63, which only happens when
+// both are math.MinInt or one is and the other is 0.
+func GCD(a, b int) int {
+	g := gcd(magnitude(a), magnitude(b))
+	if g > math.MaxInt {
+		panic(fmt.Sprintf("numtheory: GCD(%d, %d) overflows int", a, b))
+	}
+	return int(g)
+}
+
+// GCDAll is the greatest common divisor of every number, or 0 for none.
+func GCDAll(nums ...int) int {
+	g := 0
+	for _, n := range nums {
+		g = GCD(g, n)
+	}
+	return g
+}
+
+// Multiplies two non-negative ints, reporting false if the product doesn't
+// fit in an int.
+func multiply(a, b int) (int, bool) {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	if hi != 0 || lo > math.MaxInt {
+		return 0, false
+	}
+	return int(lo), true
+}
+
+// LCM is the least common multiple of a and b, which is never negative.
+// LCM(a, 0) is 0.
+func LCM(a, b int) (int, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+
+	ma, mb := magnitude(a), magnitude(b)
+	if ma > math.MaxInt || mb > math.MaxInt {
+		return 0, ErrOverflow
+	}
+
+	// Dividing first keeps the intermediate value no bigger than the answer
+	lcm, ok := multiply(int(ma/gcd(ma, mb)), int(mb))
+	if !ok {
+		return 0, ErrOverflow
+	}
+	return lcm, nil
+}
+
+// LCMAll is the least common multiple of every number, or 1 for none.
+func LCMAll(nums ...int) (int, error) {
+	lcm := 1
+	for _, n := range nums {
+		var err error
+		if lcm, err = LCM(lcm, n); err != nil {
+			return 0, err
+		}
+	}
+	return lcm, nil
+}
+
+// BigLCM is LCMAll without the limit on the size of the result.
+func BigLCM(nums ...int) *big.Int {
+	lcm := big.NewInt(1)
+	g := new(big.Int)
+
+	for _, n := range nums {
+		b := new(big.Int).Abs(big.NewInt(int64(n)))
+		if b.Sign() == 0 {
+			return b
+		}
+
+		g.GCD(nil, nil, lcm, b)
+		lcm.Mul(lcm.Div(lcm, g), b)
+	}
+	return lcm
+}
+
+// ExtendedGCD returns g = GCD(a, b) along with x and y such that
+// a*x + b*y = g. The coefficients are the smallest ones Euclid's algorithm
+// finds, so |x| <= |b| and |y| <= |a| and they can't overflow.
+func ExtendedGCD(a, b int) (g, x, y int) {
+	oldR, r := a, b
+	oldX, x := 1, 0
+	oldY, y := 0, 1
+
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldX, x = x, oldX-q*x
+		oldY, y = y, oldY-q*y
+	}
+
+	if oldR < 0 {
+		return -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// Mod is n modulo m, always in [0, m) for a positive m.
+func Mod(n, m int) int {
+	r := n % m
+	if r < 0 {
+		r += m
+	}
+	return r
+}
+
+// MulMod is a*b modulo m, computed exactly even when a*b wouldn't fit in an
+// int. m must be positive.
+func MulMod(a, b, m int) int {
+	hi, lo := bits.Mul64(uint64(Mod(a, m)), uint64(Mod(b, m)))
+	_, rem := bits.Div64(hi, lo, uint64(m))
+	return int(rem)
+}
+
+// ModPow is base^exp modulo m, for a non-negative exp and positive m.
+func ModPow(base, exp, m int) int {
+	if exp < 0 {
+		panic(fmt.Sprintf("numtheory: ModPow with negative exponent %d", exp))
+	}
+
+	result, base := Mod(1, m), Mod(base, m)
+	for ; exp > 0; exp >>= 1 {
+		if exp&1 == 1 {
+			result = MulMod(result, base, m)
+		}
+		base = MulMod(base, base, m)
+	}
+	return result
+}
+
+// ModInverse returns x in [0, m) with a*x = 1 modulo m, or ErrNotInvertible
+// if a and m share a factor.
+func ModInverse(a, m int) (int, error) {
+	g, x, _ := ExtendedGCD(Mod(a, m), m)
+	if g != 1 {
+		return 0, fmt.Errorf("%d modulo %d: %w", a, m, ErrNotInvertible)
+	}
+	return Mod(x, m), nil
+}
+
+// CRT solves the system x = residues[i] modulo moduli[i], returning the
+// smallest non-negative x and the modulus it repeats with, the lcm of the
+// moduli. The moduli needn't be coprime; if they share a factor the
+// residues must agree on it, or there's ErrNoSolution. If the combined
+// modulus doesn't fit in an int the result is ErrOverflow, and BigCRT can
+// solve it instead.
+func CRT(residues, moduli []int) (x, modulus int, err error) {
+	if len(residues) != len(moduli) {
+		panic(fmt.Sprintf("numtheory: CRT with %d residues but %d moduli", len(residues), len(moduli)))
+	}
+
+	x, modulus = 0, 1
+	for i, m := range moduli {
+		if m <= 0 {
+			panic(fmt.Sprintf("numtheory: CRT modulus %d isn't positive", m))
+		}
+		r := Mod(residues[i], m)
+
+		// Solve x + modulus*t = r (mod m) for t
+		g := GCD(modulus, m)
+		if (r-x)%g != 0 {
+			return 0, 0, fmt.Errorf("x = %d (mod %d) and x = %d (mod %d): %w", x, modulus, r, m, ErrNoSolution)
+		}
+
+		combined, err := LCM(modulus, m)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		reduced := m / g
+		inverse, _ := ModInverse(modulus/g, reduced) // modulus/g and m/g are coprime
+		t := MulMod((r-x)/g, inverse, reduced)
+
+		// t < m/g, so modulus*t < combined and this can't overflow
+		x, modulus = x+modulus*t, combined
+	}
+
+	return x, modulus, nil
+}
+
+// BigCRT is CRT without the limit on the size of the result.
+func BigCRT(residues, moduli []int) (x, modulus *big.Int, err error) {
+	if len(residues) != len(moduli) {
+		panic(fmt.Sprintf("numtheory: BigCRT with %d residues but %d moduli", len(residues), len(moduli)))
+	}
+
+	x, modulus = big.NewInt(0), big.NewInt(1)
+	g, inverse, diff, reduced := new(big.Int), new(big.Int), new(big.Int), new(big.Int)
+
+	for i, n := range moduli {
+		if n <= 0 {
+			panic(fmt.Sprintf("numtheory: BigCRT modulus %d isn't positive", n))
+		}
+		m := big.NewInt(int64(n))
+		r := new(big.Int).Mod(big.NewInt(int64(residues[i])), m)
+
+		g.GCD(nil, nil, modulus, m)
+		diff.Sub(r, x)
+		if new(big.Int).Mod(diff, g).Sign() != 0 {
+			return nil, nil, fmt.Errorf("x = %v (mod %v) and x = %v (mod %v): %w", x, modulus, r, m, ErrNoSolution)
+		}
+
+		reduced.Div(m, g)
+		inverse.ModInverse(new(big.Int).Div(modulus, g), reduced)
+		t := diff.Div(diff, g)
+		t.Mul(t, inverse).Mod(t, reduced)
+
+		x.Add(x, t.Mul(t, modulus))
+		modulus.Mul(modulus.Div(modulus, g), m)
+	}
+
+	return x, modulus, nil
+}
@@ -0,0 +1,234 @@
+package numtheory
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestGCD(t *testing.T) {
+	tests := []struct{ a, b, want int }{
+		{0, 0, 0},
+		{12, 0, 12},
+		{0, -12, 12},
+		{12, 18, 6},
+		{-12, 18, 6},
+		{17, 5, 1},
+		{math.MinInt, 6, 2},
+		{math.MaxInt, math.MaxInt, math.MaxInt},
+	}
+
+	for _, test := range tests {
+		if got := GCD(test.a, test.b); got != test.want {
+			t.Errorf("GCD(%d, %d) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+
+	if got := GCDAll(24, -36, 60); got != 12 {
+		t.Errorf("GCDAll(24, -36, 60) = %d, want 12", got)
+	}
+}
+
+func TestLCM(t *testing.T) {
+	tests := []struct {
+		nums []int
+		want int
+	}{
+		{nil, 1},
+		{[]int{4, 6}, 12},
+		{[]int{-4, 6}, 12},
+		{[]int{2, 3, 0}, 0},
+		{[]int{2, 3, 4, 5, 6}, 60},
+		// Loop lengths like day 8's, which all share a factor of 263
+		{[]int{20777, 18673, 13939, 17621, 19199, 12361}, 17972669116327},
+	}
+
+	for _, test := range tests {
+		got, err := LCMAll(test.nums...)
+		if err != nil || got != test.want {
+			t.Errorf("LCMAll(%v) = %d, %v, want %d", test.nums, got, err, test.want)
+		}
+		if big := BigLCM(test.nums...); !big.IsInt64() || big.Int64() != int64(test.want) {
+			t.Errorf("BigLCM(%v) = %v, want %d", test.nums, big, test.want)
+		}
+	}
+}
+
+func TestLCMOverflow(t *testing.T) {
+	// The first 16 primes multiply to more than 2^63
+	primes := []int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53}
+
+	if _, err := LCMAll(primes...); !errors.Is(err, ErrOverflow) {
+		t.Errorf("got %v, want ErrOverflow", err)
+	}
+	if _, err := LCM(math.MinInt, 1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("LCM(MinInt, 1) gave %v, want ErrOverflow", err)
+	}
+
+	want := big.NewInt(1)
+	for _, p := range primes {
+		want.Mul(want, big.NewInt(int64(p)))
+	}
+	if got := BigLCM(primes...); got.Cmp(want) != 0 {
+		t.Errorf("BigLCM = %v, want %v", got, want)
+	}
+}
+
+func TestExtendedGCD(t *testing.T) {
+	rng := rand.New(rand.NewSource(19))
+
+	for i := 0; i < 1000; i++ {
+		a, b := rng.Intn(2001)-1000, rng.Intn(2001)-1000
+		g, x, y := ExtendedGCD(a, b)
+
+		if g != GCD(a, b) || a*x+b*y != g {
+			t.Fatalf("ExtendedGCD(%d, %d) = %d, %d, %d", a, b, g, x, y)
+		}
+	}
+
+	// Big inputs shouldn't overflow the coefficients
+	a, b := math.MaxInt, math.MaxInt-1
+	g, x, y := ExtendedGCD(a, b)
+	check := new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(int64(x)))
+	check.Add(check, new(big.Int).Mul(big.NewInt(int64(b)), big.NewInt(int64(y))))
+	if g != 1 || !check.IsInt64() || check.Int64() != 1 {
+		t.Errorf("ExtendedGCD(%d, %d) = %d, %d, %d", a, b, g, x, y)
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	for _, m := range []int{2, 7, 12, 97} {
+		for a := -m; a < 2*m; a++ {
+			inverse, err := ModInverse(a, m)
+			if GCD(a, m) != 1 {
+				if !errors.Is(err, ErrNotInvertible) {
+					t.Errorf("ModInverse(%d, %d) gave %v, want ErrNotInvertible", a, m, err)
+				}
+				continue
+			}
+
+			if err != nil || inverse < 0 || inverse >= m || Mod(a*inverse, m) != 1%m {
+				t.Errorf("ModInverse(%d, %d) = %d, %v", a, m, inverse, err)
+			}
+		}
+	}
+}
+
+func TestModPow(t *testing.T) {
+	// Checked against math/big rather than by hand
+	tests := []struct{ base, exp, m int }{
+		{2, 10, 1000},
+		{-2, 3, 7},
+		{5, 0, 13},
+		{5, 0, 1},
+		{3, 200, 1_000_000_007},
+		// Squares past 2^63 on the way, so it needs the exact product
+		{math.MaxInt - 1, math.MaxInt - 1, math.MaxInt},
+	}
+
+	for _, test := range tests {
+		want := new(big.Int).Exp(big.NewInt(int64(test.base)), big.NewInt(int64(test.exp)), big.NewInt(int64(test.m)))
+		if got := ModPow(test.base, test.exp, test.m); int64(got) != want.Int64() {
+			t.Errorf("ModPow(%d, %d, %d) = %d, want %v", test.base, test.exp, test.m, got, want)
+		}
+	}
+}
+
+func TestCRT(t *testing.T) {
+	tests := []struct {
+		name              string
+		residues, moduli  []int
+		want, wantModulus int
+	}{
+		{"empty", nil, nil, 0, 1},
+		{"coprime", []int{2, 3, 2}, []int{3, 5, 7}, 23, 105},
+		{"negative residues", []int{-1, -1}, []int{4, 9}, 35, 36},
+		{"shared factor", []int{2, 8}, []int{6, 10}, 8, 30},
+		{"one divides another", []int{3, 7}, []int{4, 12}, 7, 12},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, modulus, err := CRT(test.residues, test.moduli)
+			if err != nil || got != test.want || modulus != test.wantModulus {
+				t.Errorf("got %d (mod %d), %v, want %d (mod %d)", got, modulus, err, test.want, test.wantModulus)
+			}
+
+			bigGot, bigModulus, err := BigCRT(test.residues, test.moduli)
+			if err != nil || bigGot.Int64() != int64(test.want) || bigModulus.Int64() != int64(test.wantModulus) {
+				t.Errorf("BigCRT got %v (mod %v), %v", bigGot, bigModulus, err)
+			}
+		})
+	}
+}
+
+func TestCRTNoSolution(t *testing.T) {
+	// Odd modulo 4 but even modulo 6
+	if _, _, err := CRT([]int{1, 2}, []int{4, 6}); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("CRT gave %v, want ErrNoSolution", err)
+	}
+	if _, _, err := BigCRT([]int{1, 2}, []int{4, 6}); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("BigCRT gave %v, want ErrNoSolution", err)
+	}
+}
+
+func TestCRTMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+
+	for i := 0; i < 300; i++ {
+		moduli := make([]int, 1+rng.Intn(3))
+		residues := make([]int, len(moduli))
+		for j := range moduli {
+			moduli[j] = 1 + rng.Intn(12)
+			residues[j] = rng.Intn(30) - 10
+		}
+
+		modulus, _ := LCMAll(moduli...)
+		want := -1
+		for x := 0; x < modulus && want < 0; x++ {
+			solves := true
+			for j, m := range moduli {
+				solves = solves && Mod(x, m) == Mod(residues[j], m)
+			}
+			if solves {
+				want = x
+			}
+		}
+
+		got, gotModulus, err := CRT(residues, moduli)
+		if want < 0 {
+			if !errors.Is(err, ErrNoSolution) {
+				t.Fatalf("CRT(%v, %v) = %d, %v, want ErrNoSolution", residues, moduli, got, err)
+			}
+			continue
+		}
+		if err != nil || got != want || gotModulus != modulus {
+			t.Fatalf("CRT(%v, %v) = %d (mod %d), %v, want %d (mod %d)", residues, moduli, got, gotModulus, err, want, modulus)
+		}
+	}
+}
+
+func TestCRTOverflow(t *testing.T) {
+	residues := []int{1, 2, 3}
+	moduli := []int{math.MaxInt32, math.MaxInt32 - 1, math.MaxInt32 - 2}
+
+	if _, _, err := CRT(residues, moduli); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("got %v, want ErrOverflow", err)
+	}
+
+	x, modulus, err := BigCRT(residues, moduli)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, m := range moduli {
+		r := new(big.Int).Mod(x, big.NewInt(int64(m)))
+		if r.Int64() != int64(residues[i]) {
+			t.Errorf("%v is %v modulo %d, want %d", x, r, m, residues[i])
+		}
+	}
+	if x.Sign() < 0 || x.Cmp(modulus) >= 0 {
+		t.Errorf("%v isn't reduced modulo %v", x, modulus)
+	}
+}
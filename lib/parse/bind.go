@@ -0,0 +1,110 @@
+package parse
+
+import (
+	"AoC_2023/lib"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var fieldType = reflect.TypeOf(lib.Field{})
+
+// Bind matches pattern against the scanner's current line and stores each
+// named group in the field of the struct dst points to with the same name,
+// ignoring case, or with a `parse:"<group>"` tag. Adding ",hex" to the tag
+// reads the group as a hexadecimal number.
+//
+// Fields can be strings, any int or uint type, or a lib.Field to keep the
+// group's column. Groups that didn't take part in the match leave their
+// field alone. A line that doesn't match, or a number that won't parse, is
+// reported as a *lib.ParseError; a group with no field to go in is a bug,
+// so it panics.
+func Bind(scanner *lib.Scanner, pattern *regexp.Regexp, dst any) error {
+	line := scanner.Text()
+	match := pattern.FindStringSubmatchIndex(line)
+	if match == nil {
+		return scanner.Errorf(0, line, "want a line matching %s", pattern)
+	}
+
+	target := reflect.ValueOf(dst)
+	if target.Kind() != reflect.Pointer || target.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("parse.Bind needs a pointer to a struct, got %T", dst))
+	}
+	target = target.Elem()
+
+	for group, name := range pattern.SubexpNames() {
+		start, end := match[2*group], match[2*group+1]
+		if name == "" || start < 0 {
+			continue
+		}
+
+		field, base := fieldFor(target, name)
+		text, column := line[start:end], start+1
+
+		if err := set(scanner, field, lib.Field{Text: text, Column: column}, base); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Finds the struct field for a group, along with the base to read it in.
+func fieldFor(target reflect.Value, group string) (reflect.Value, int) {
+	structType := target.Type()
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		name, options, _ := strings.Cut(field.Tag.Get("parse"), ",")
+
+		base := 10
+		if options == "hex" {
+			base = 16
+		}
+
+		if name == group || (name == "" && strings.EqualFold(field.Name, group)) {
+			return target.Field(i), base
+		}
+	}
+
+	panic(fmt.Sprintf("parse.Bind: %s has no field for group %q", structType, group))
+}
+
+func set(scanner *lib.Scanner, field reflect.Value, group lib.Field, base int) error {
+	if field.Type() == fieldType {
+		field.Set(reflect.ValueOf(group))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(group.Text)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(group.Text, base, field.Type().Bits())
+		if err != nil {
+			return numberError(scanner, group, err)
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(group.Text, base, field.Type().Bits())
+		if err != nil {
+			return numberError(scanner, group, err)
+		}
+		field.SetUint(n)
+	default:
+		panic(fmt.Sprintf("parse.Bind can't store a group in a %s", field.Type()))
+	}
+
+	return nil
+}
+
+func numberError(scanner *lib.Scanner, group lib.Field, err error) error {
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		err = numErr.Err
+	}
+	return scanner.Errorf(group.Column, group.Text, "bad number: %v", err)
+}
@@ -0,0 +1,97 @@
+// Package parse reads the shapes puzzle input usually comes in: lines of
+// numbers, "key: values" headers, grids, blank-line separated sections and
+// lines that fit a regexp. Everything reads through a lib.Scanner, so errors
+// are *lib.ParseError values pointing at the offending line and column.
+package parse
+
+import (
+	"AoC_2023/lib"
+	"regexp"
+	"strings"
+)
+
+var intPattern = regexp.MustCompile(`[-+]?[0-9]+`)
+
+// Ints returns every signed int in field, in order. A '-' or '+' only counts
+// as a sign when a digit follows it, so "seed-to-soil 3" is just 3.
+func Ints(scanner *lib.Scanner, field lib.Field) ([]int, error) {
+	matches := intPattern.FindAllStringIndex(field.Text, -1)
+	ints := make([]int, len(matches))
+
+	for i, match := range matches {
+		n, err := scanner.Atoi(lib.Field{Text: field.Text[match[0]:match[1]], Column: field.Column + match[0]})
+		if err != nil {
+			return nil, err
+		}
+		ints[i] = n
+	}
+
+	return ints, nil
+}
+
+// Header scans the next line, which should be "<key>: <values>", and
+// returns the values with surrounding whitespace trimmed.
+func Header(scanner *lib.Scanner, key string) (lib.Field, error) {
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return lib.Field{}, err
+		}
+		return lib.Field{}, scanner.InputErrorf("missing the %s line", key)
+	}
+
+	label, values, found := scanner.Field().Cut(":")
+	if !found || strings.TrimSpace(label.Text) != key {
+		return lib.Field{}, scanner.Errorf(0, scanner.Text(), "want \"%s: <values>\"", key)
+	}
+
+	return values.Trim(), nil
+}
+
+// HeaderInts scans a "<key>: <numbers>" line, returning the numbers.
+func HeaderInts(scanner *lib.Scanner, key string) ([]int, error) {
+	values, err := Header(scanner, key)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := values.Fields()
+	ints := make([]int, len(fields))
+	for i, field := range fields {
+		if ints[i], err = scanner.Atoi(field); err != nil {
+			return nil, err
+		}
+	}
+
+	return ints, nil
+}
+
+// Sections calls read once for each run of lines separated by blank lines,
+// handing it a scanner over just that section. Any lines read leaves unread
+// are skipped. Reading stops at the first error.
+func Sections(scanner *lib.Scanner, read func(section *lib.Scanner) error) error {
+	for {
+		section, ok := scanner.NextSection()
+		if !ok {
+			return scanner.Err()
+		}
+
+		if err := read(section); err != nil {
+			return err
+		}
+	}
+}
+
+// Grid reads the rest of the scanner's lines as a rectangular grid, turning
+// each rune into a cell with cell.
+func Grid[T any](scanner *lib.Scanner, cell func(rune) (T, bool)) ([][]T, error) {
+	grid, err := lib.ReadGrid(scanner, cell)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([][]T, grid.Rows())
+	for i := range rows {
+		rows[i] = grid.Row(i)
+	}
+	return rows, nil
+}
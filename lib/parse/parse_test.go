@@ -0,0 +1,188 @@
+package parse
+
+import (
+	"AoC_2023/lib"
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func scannerFor(input string) *lib.Scanner {
+	return lib.NewScanner(1, strings.NewReader(input))
+}
+
+// Checks err is a *lib.ParseError pointing at line and column.
+func checkError(t *testing.T, err error, line, column int) {
+	t.Helper()
+
+	var parseErr *lib.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("got %v, want a *lib.ParseError", err)
+	}
+	if parseErr.Line != line || parseErr.Column != column {
+		t.Errorf("got line %d column %d, want line %d column %d (%v)",
+			parseErr.Line, parseErr.Column, line, column, err)
+	}
+}
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		line string
+		want []int
+	}{
+		{"Game 12: 3 blue, 4 red", []int{12, 3, 4}},
+		{"0 3 6 -9 +12", []int{0, 3, 6, -9, 12}},
+		{"x=-4, y=10..-2", []int{-4, 10, -2}},
+		{"seed-to-soil map:", []int{}},
+		{"", []int{}},
+	}
+
+	for _, test := range tests {
+		scanner := scannerFor(test.line)
+		scanner.Scan()
+
+		got, err := Ints(scanner, scanner.Field())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(got, test.want) {
+			t.Errorf("Ints(%q) = %v, want %v", test.line, got, test.want)
+		}
+	}
+}
+
+func TestIntsOverflow(t *testing.T) {
+	scanner := scannerFor("fine\n1 99999999999999999999")
+	scanner.Scan()
+	scanner.Scan()
+
+	_, err := Ints(scanner, scanner.Field())
+	checkError(t, err, 2, 3)
+}
+
+func TestHeader(t *testing.T) {
+	scanner := scannerFor("Time:      7  15   30\nDistance:  9  40  200\nTime 1 2")
+
+	times, err := HeaderInts(scanner, "Time")
+	if err != nil || !slices.Equal(times, []int{7, 15, 30}) {
+		t.Errorf("got %v %v, want [7 15 30]", times, err)
+	}
+
+	distances, err := Header(scanner, "Distance")
+	if err != nil || distances.Text != "9  40  200" || distances.Column != 12 {
+		t.Errorf("got %+v %v, want \"9  40  200\" at column 12", distances, err)
+	}
+
+	_, err = Header(scanner, "Time")
+	checkError(t, err, 3, 0)
+
+	_, err = Header(scanner, "Time")
+	checkError(t, err, 0, 0)
+}
+
+func TestSections(t *testing.T) {
+	input := "a\nb\n\n\nc\nd\ne\n\nf\n"
+	scanner := scannerFor(input)
+
+	var got [][]string
+	var lines [][]int
+	err := Sections(scanner, func(section *lib.Scanner) error {
+		var text []string
+		var numbers []int
+		for section.Scan() {
+			text = append(text, section.Text())
+			numbers = append(numbers, section.Line())
+
+			// Leaving the rest unread shouldn't leak into the next section
+			if section.Text() == "d" {
+				break
+			}
+		}
+		got = append(got, text)
+		lines = append(lines, numbers)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]string{{"a", "b"}, {"c", "d"}, {"f"}}
+	wantLines := [][]int{{1, 2}, {5, 6}, {9}}
+	for i := range want {
+		if i >= len(got) || !slices.Equal(got[i], want[i]) || !slices.Equal(lines[i], wantLines[i]) {
+			t.Fatalf("got sections %v on lines %v, want %v on lines %v", got, lines, want, wantLines)
+		}
+	}
+}
+
+func TestSectionErrorsHaveInputLines(t *testing.T) {
+	scanner := scannerFor("#.\n.#\n\n#.\n.X")
+
+	var grids [][][]bool
+	err := Sections(scanner, func(section *lib.Scanner) error {
+		grid, err := Grid(section, func(ch rune) (bool, bool) {
+			return ch == '#', ch == '#' || ch == '.'
+		})
+		grids = append(grids, grid)
+		return err
+	})
+
+	checkError(t, err, 5, 2)
+	if len(grids) != 2 || !slices.Equal(grids[0][1], []bool{false, true}) {
+		t.Errorf("got grids %v", grids)
+	}
+}
+
+func TestBind(t *testing.T) {
+	type edge struct {
+		Direction string
+		Length    int
+		Color     uint32    `parse:",hex"`
+		Label     lib.Field `parse:"name"`
+		Missing   int
+	}
+	pattern := regexp.MustCompile(`^(?P<direction>[URDL]) (?P<length>-?\d+) \(#(?P<color>[0-9a-f]+)\)(?: (?P<name>\w+))?(?: (?P<missing>\d+))?$`)
+
+	scanner := scannerFor("R 6 (#70c710) first\nD -5 (#0dc571)\nL x (#8ceee2)\nU 2 (#fffffffff)")
+
+	scanner.Scan()
+	var got edge
+	if err := Bind(scanner, pattern, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := edge{"R", 6, 0x70c710, lib.Field{Text: "first", Column: 15}, 0}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	scanner.Scan()
+	got = edge{Missing: 7}
+	if err := Bind(scanner, pattern, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Length != -5 || got.Label != (lib.Field{}) || got.Missing != 7 {
+		t.Errorf("optional groups that didn't match changed fields: %+v", got)
+	}
+
+	scanner.Scan()
+	checkError(t, Bind(scanner, pattern, &got), 3, 0)
+
+	scanner.Scan()
+	checkError(t, Bind(scanner, pattern, &got), 4, 7)
+}
+
+func TestBindWithoutAFieldPanics(t *testing.T) {
+	scanner := scannerFor("abc")
+	scanner.Scan()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("a group with nowhere to go didn't panic")
+		}
+	}()
+
+	var dst struct{ Other string }
+	Bind(scanner, regexp.MustCompile(`(?P<word>\w+)`), &dst)
+}
@@ -0,0 +1,94 @@
+package lib
+
+import (
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Solver is implemented by every day. Parse turns the raw puzzle input into
+// whatever shape the day wants to work with, reporting malformed input as a
+// *ParseError, and the parts answer the puzzle from that parsed input
+// without modifying it.
+type Solver[T any] interface {
+	Parse(r io.Reader) (T, error)
+	Part1(input T) int
+	Part2(input T) int
+}
+
+// CheckedSolver is a Solver whose parts can fail in ways Parse can't see
+// coming, like an answer too big for an int. Its plain parts return 0 when
+// the checked ones would fail.
+type CheckedSolver[T any] interface {
+	Solver[T]
+	CheckedPart1(input T) (int, error)
+	CheckedPart2(input T) (int, error)
+}
+
+// Day is a registered solution with its input type erased so that days with
+// wildly different puzzle inputs can live in the same registry.
+type Day struct {
+	Number int
+	CheckedSolver[any]
+}
+
+// Adapts a typed Solver to CheckedSolver[any], with checked parts that never
+// fail for days that don't have any. The input handed to the parts always
+// comes from the same solver's Parse, so the type assertions can't fail.
+type erasedSolver[T any] struct {
+	solver Solver[T]
+}
+
+func (self erasedSolver[T]) Parse(r io.Reader) (any, error) {
+	return self.solver.Parse(r)
+}
+
+func (self erasedSolver[T]) Part1(input any) int {
+	return self.solver.Part1(input.(T))
+}
+
+func (self erasedSolver[T]) Part2(input any) int {
+	return self.solver.Part2(input.(T))
+}
+
+func (self erasedSolver[T]) CheckedPart1(input any) (int, error) {
+	if checked, ok := self.solver.(CheckedSolver[T]); ok {
+		return checked.CheckedPart1(input.(T))
+	}
+	return self.Part1(input), nil
+}
+
+func (self erasedSolver[T]) CheckedPart2(input any) (int, error) {
+	if checked, ok := self.solver.(CheckedSolver[T]); ok {
+		return checked.CheckedPart2(input.(T))
+	}
+	return self.Part2(input), nil
+}
+
+var registry = make(map[int]Day)
+
+// Register makes a day's solution available to the runner. Days call this
+// from an init func, so importing the day's package is enough to register it.
+func Register[T any](number int, solver Solver[T]) {
+	if _, exists := registry[number]; exists {
+		panic(fmt.Sprintf("day %02d registered twice", number))
+	}
+
+	registry[number] = Day{number, erasedSolver[T]{solver}}
+}
+
+func Lookup(number int) (Day, bool) {
+	day, ok := registry[number]
+	return day, ok
+}
+
+// Days returns every registered day, ordered by day number.
+func Days() []Day {
+	days := make([]Day, 0, len(registry))
+	for _, day := range registry {
+		days = append(days, day)
+	}
+
+	slices.SortFunc(days, func(a, b Day) int { return a.Number - b.Number })
+	return days
+}
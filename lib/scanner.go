@@ -0,0 +1,222 @@
+package lib
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ParseError points at the part of a puzzle input that a day couldn't make
+// sense of. Line and Column are 1-based; a zero Line means the problem is
+// with the input as a whole, and a zero Column means the whole line.
+type ParseError struct {
+	Day    int
+	Line   int
+	Column int
+	Text   string
+	Err    error
+}
+
+func (self *ParseError) Error() string {
+	location := fmt.Sprintf("day %02d", self.Day)
+	if self.Line > 0 {
+		location += fmt.Sprintf(", line %d", self.Line)
+	}
+	if self.Column > 0 {
+		location += fmt.Sprintf(", column %d", self.Column)
+	}
+
+	if self.Text == "" {
+		return fmt.Sprintf("%s: %v", location, self.Err)
+	}
+	return fmt.Sprintf("%s: %v: %q", location, self.Err, self.Text)
+}
+
+func (self *ParseError) Unwrap() error {
+	return self.Err
+}
+
+// Scanner is a bufio.Scanner that keeps track of which line it's on, so
+// that parsers can report exactly where the input went wrong.
+type Scanner struct {
+	*bufio.Scanner
+	day  int
+	line int
+
+	// A section reads its parent's lines up to the next blank one. The
+	// parent keeps hold of its current section so it can skip whatever the
+	// section's reader left unread.
+	parent  *Scanner
+	section *Scanner
+	pending bool // The section's first line is scanned but not yet handed out
+	ended   bool
+}
+
+func NewScanner(day int, r io.Reader) *Scanner {
+	return &Scanner{Scanner: bufio.NewScanner(r), day: day}
+}
+
+func (self *Scanner) Scan() bool {
+	if self.parent != nil {
+		return self.scanSection()
+	}
+
+	if !self.Scanner.Scan() {
+		return false
+	}
+
+	self.line++
+	return true
+}
+
+func (self *Scanner) scanSection() bool {
+	if self.pending {
+		self.pending = false
+		return true
+	}
+
+	if self.ended || !self.parent.Scan() || self.parent.Text() == "" {
+		self.ended = true
+		return false
+	}
+
+	self.line = self.parent.line
+	return true
+}
+
+// NextSection skips any blank lines and returns a scanner over the lines up
+// to the next blank line, or reports false at the end of the input. Lines
+// keep the numbers they have in the whole input, so errors from the section
+// point at the right place.
+func (self *Scanner) NextSection() (*Scanner, bool) {
+	if self.section != nil {
+		for self.section.Scan() {
+		}
+		self.section = nil
+	}
+
+	for self.Scan() {
+		if self.Text() != "" {
+			self.section = &Scanner{
+				Scanner: self.Scanner,
+				day:     self.day,
+				line:    self.line,
+				parent:  self,
+				pending: true,
+			}
+			return self.section, true
+		}
+	}
+
+	return nil, false
+}
+
+// Line is the 1-based number of the line most recently scanned.
+func (self *Scanner) Line() int {
+	return self.line
+}
+
+// Field is the whole current line, for splitting up with column tracking.
+func (self *Scanner) Field() Field {
+	return Field{self.Text(), 1}
+}
+
+// Err reports any read error, attributed to the line that failed to scan.
+func (self *Scanner) Err() error {
+	if err := self.Scanner.Err(); err != nil {
+		return &ParseError{Day: self.day, Line: self.line + 1, Err: err}
+	}
+	return nil
+}
+
+// Errorf describes a problem with text found at column of the current line.
+func (self *Scanner) Errorf(column int, text string, format string, args ...any) error {
+	return self.Error(column, text, fmt.Errorf(format, args...))
+}
+
+func (self *Scanner) Error(column int, text string, err error) error {
+	return &ParseError{Day: self.day, Line: self.line, Column: column, Text: text, Err: err}
+}
+
+// InputErrorf describes a problem with the input as a whole, like a
+// missing start marker, rather than any one line.
+func (self *Scanner) InputErrorf(format string, args ...any) error {
+	return &ParseError{Day: self.day, Err: fmt.Errorf(format, args...)}
+}
+
+// Atoi parses a field of the current line, reporting where it was on failure.
+func (self *Scanner) Atoi(field Field) (int, error) {
+	n, err := strconv.Atoi(field.Text)
+	if err != nil {
+		var numErr *strconv.NumError
+		if errors.As(err, &numErr) {
+			err = numErr.Err
+		}
+		return 0, self.Errorf(field.Column, field.Text, "bad number: %v", err)
+	}
+	return n, nil
+}
+
+// Field is a piece of a line along with the 1-based column it started at.
+type Field struct {
+	Text   string
+	Column int
+}
+
+// Fields splits s around runs of whitespace like strings.Fields, keeping
+// track of columns. column is where s itself starts in the line.
+func Fields(s string, column int) []Field {
+	fields := make([]Field, 0)
+	start := -1
+
+	for i, ch := range s + " " {
+		isSpace := ch == ' ' || ch == '\t'
+		if !isSpace && start < 0 {
+			start = i
+		} else if isSpace && start >= 0 {
+			fields = append(fields, Field{s[start:i], column + start})
+			start = -1
+		}
+	}
+
+	return fields
+}
+
+// Split is strings.Split for a field, keeping track of columns.
+func (self Field) Split(sep string) []Field {
+	parts := strings.Split(self.Text, sep)
+	fields := make([]Field, len(parts))
+
+	column := self.Column
+	for i, part := range parts {
+		fields[i] = Field{part, column}
+		column += len(part) + len(sep)
+	}
+
+	return fields
+}
+
+// Cut is strings.Cut for a field, keeping track of columns.
+func (self Field) Cut(sep string) (before, after Field, found bool) {
+	b, a, found := strings.Cut(self.Text, sep)
+	before = Field{b, self.Column}
+	after = Field{a, self.Column + len(b) + len(sep)}
+	return before, after, found
+}
+
+// Fields is Fields for a field, keeping track of columns.
+func (self Field) Fields() []Field {
+	return Fields(self.Text, self.Column)
+}
+
+// Trim trims leading and trailing whitespace, keeping track of columns.
+func (self Field) Trim() Field {
+	trimmedLeft := strings.TrimLeft(self.Text, " \t")
+	return Field{
+		strings.TrimRight(trimmedLeft, " \t"),
+		self.Column + len(self.Text) - len(trimmedLeft),
+	}
+}
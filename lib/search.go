@@ -0,0 +1,181 @@
+package lib
+
+import "slices"
+
+// Neighbor is a state one move away, along with what the move costs.
+type Neighbor[S comparable] struct {
+	State S
+	Cost  int
+}
+
+// SearchResult describes the cheapest path a search found. Path runs from
+// the start to the goal, both included. Visited counts the states the search
+// expanded, which is a decent measure of how hard it had to work.
+type SearchResult[S comparable] struct {
+	Found   bool
+	Cost    int
+	Path    []S
+	Visited int
+}
+
+// BFS finds the path to a goal with the fewest moves, treating every move as
+// costing 1.
+func BFS[S comparable](start S, neighbors func(S) []S, goal func(S) bool) SearchResult[S] {
+	parents := make(map[S]S)
+	seen := SetOf(start)
+	queue := NewDeque[S]()
+	queue.PushBack(start)
+	result := SearchResult[S]{}
+
+	for {
+		state, ok := queue.PopFront()
+		if !ok {
+			return result
+		}
+		result.Visited++
+
+		if goal(state) {
+			result.Found = true
+			result.Path = walkBack(start, state, parents)
+			result.Cost = len(result.Path) - 1
+			return result
+		}
+
+		for _, next := range neighbors(state) {
+			if seen.Add(next) {
+				parents[next] = state
+				queue.PushBack(next)
+			}
+		}
+	}
+}
+
+// Reachable returns every state that can be reached from start, start
+// included.
+func Reachable[S comparable](start S, neighbors func(S) []S) Set[S] {
+	seen := SetOf(start)
+	stack := NewDeque[S]()
+	stack.PushBack(start)
+
+	for {
+		state, ok := stack.PopBack()
+		if !ok {
+			return seen
+		}
+
+		for _, next := range neighbors(state) {
+			if seen.Add(next) {
+				stack.PushBack(next)
+			}
+		}
+	}
+}
+
+// Dijkstra finds the cheapest path to a goal. Costs must not be negative.
+func Dijkstra[S comparable](start S, neighbors func(S) []Neighbor[S], goal func(S) bool) SearchResult[S] {
+	return AStar(start, neighbors, goal, nil)
+}
+
+// AStar finds the cheapest path to a goal, exploring states in order of
+// their cost so far plus heuristic's estimate of the cost still to go. The
+// estimate must never be more than the real remaining cost, or the path
+// found might not be the cheapest. A nil heuristic makes this Dijkstra.
+// The slice neighbors returns is only read until the next call, so it can
+// be reused.
+func AStar[S comparable](start S, neighbors func(S) []Neighbor[S], goal func(S) bool, heuristic func(S) int) SearchResult[S] {
+	return aStar(start, neighbors, goal, heuristic, true)
+}
+
+// AStarCost is AStar for when only the cost matters. It leaves Path empty,
+// which saves remembering how every state was reached.
+func AStarCost[S comparable](start S, neighbors func(S) []Neighbor[S], goal func(S) bool, heuristic func(S) int) SearchResult[S] {
+	return aStar(start, neighbors, goal, heuristic, false)
+}
+
+func aStar[S comparable](start S, neighbors func(S) []Neighbor[S], goal func(S) bool, heuristic func(S) int, trackPath bool) SearchResult[S] {
+	if heuristic == nil {
+		heuristic = func(S) int { return 0 }
+	}
+
+	// States are numbered as they're found, so everything but the numbering
+	// lives in slices and each neighbor costs one map lookup
+	ids := map[S]int{start: 0}
+	states, costs := []S{start}, []int{0}
+	var parents []int
+	if trackPath {
+		parents = []int{0}
+	}
+
+	queue := NewDenseIndexedHeap(func(a, b int) bool { return a < b })
+	queue.Push(0, heuristic(start))
+	result := SearchResult[S]{}
+
+	for queue.Len() > 0 {
+		id := queue.Pop().Key
+		state, cost := states[id], costs[id]
+		result.Visited++
+
+		if goal(state) {
+			result.Found = true
+			result.Cost = cost
+			if trackPath {
+				result.Path = walkBackIDs(id, states, parents)
+			}
+			return result
+		}
+
+		for _, next := range neighbors(state) {
+			nextCost := cost + next.Cost
+			nextID, found := ids[next.State]
+			if found && costs[nextID] <= nextCost {
+				continue
+			}
+
+			if found {
+				costs[nextID] = nextCost
+			} else {
+				nextID = len(states)
+				ids[next.State] = nextID
+				states = append(states, next.State)
+				costs = append(costs, nextCost)
+				queue.Grow(cap(states))
+				if trackPath {
+					parents = append(parents, 0)
+				}
+			}
+
+			if trackPath {
+				parents[nextID] = id
+			}
+			queue.DecreaseKey(nextID, nextCost+heuristic(next.State))
+		}
+	}
+
+	return result
+}
+
+// Follows parents back from end to the start, which is numbered 0, returning
+// the states in the order they were walked.
+func walkBackIDs[S any](end int, states []S, parents []int) []S {
+	path := []S{states[end]}
+	for id := end; id != 0; {
+		id = parents[id]
+		path = append(path, states[id])
+	}
+
+	slices.Reverse(path)
+	return path
+}
+
+// Follows parents back from end to start, returning the path in the order it
+// was walked.
+func walkBack[S comparable](start, end S, parents map[S]S) []S {
+	path := []S{end}
+	for state := end; state != start; {
+		state = parents[state]
+		path = append(path, state)
+	}
+
+	slices.Reverse(path)
+	return path
+}
@@ -0,0 +1,160 @@
+package lib
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// A small maze where the direct route is blocked and the only way through
+// winds down and back up again.
+const searchMaze = `S.#....
+.##.##.
+...#...
+.#...#G`
+
+func readSearchMaze(t *testing.T) (Grid[rune], Point, Point) {
+	t.Helper()
+
+	grid, err := ReadGrid(NewScanner(1, strings.NewReader(searchMaze)), func(ch rune) (rune, bool) {
+		return ch, strings.ContainsRune("S.#G", ch)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return grid, FindAll(grid, 'S')[0], FindAll(grid, 'G')[0]
+}
+
+func openNeighbors(grid Grid[rune]) func(Point) []Point {
+	return func(p Point) []Point {
+		open := make([]Point, 0, 4)
+		for _, n := range grid.Neighbors4(p) {
+			if grid.At(n) != '#' {
+				open = append(open, n)
+			}
+		}
+		return open
+	}
+}
+
+func checkPath(t *testing.T, grid Grid[rune], path []Point, start, goal Point) {
+	t.Helper()
+
+	if path[0] != start || path[len(path)-1] != goal {
+		t.Fatalf("path runs %v to %v, want %v to %v", path[0], path[len(path)-1], start, goal)
+	}
+	for i := 1; i < len(path); i++ {
+		if Manhattan(path[i-1], path[i]) != 1 || grid.At(path[i]) == '#' {
+			t.Fatalf("illegal step from %v to %v", path[i-1], path[i])
+		}
+	}
+}
+
+func TestBFS(t *testing.T) {
+	grid, start, goal := readSearchMaze(t)
+	result := BFS(start, openNeighbors(grid), func(p Point) bool { return p == goal })
+
+	if !result.Found || result.Cost != 11 {
+		t.Fatalf("got found %t cost %d, want found with cost 11", result.Found, result.Cost)
+	}
+	if len(result.Path) != result.Cost+1 {
+		t.Errorf("path has %d states for %d moves", len(result.Path), result.Cost)
+	}
+	checkPath(t, grid, result.Path, start, goal)
+}
+
+func TestBFSUnreachable(t *testing.T) {
+	grid, start, _ := readSearchMaze(t)
+	result := BFS(start, openNeighbors(grid), func(Point) bool { return false })
+
+	if result.Found || result.Path != nil {
+		t.Errorf("found a path to an impossible goal")
+	}
+	// Every open square is reachable
+	if want := len(grid.FindFunc(func(ch rune) bool { return ch != '#' })); result.Visited != want {
+		t.Errorf("visited %d states, want %d", result.Visited, want)
+	}
+}
+
+func TestBFSStartIsGoal(t *testing.T) {
+	result := BFS(3, func(int) []int { return nil }, func(n int) bool { return n == 3 })
+
+	if !result.Found || result.Cost != 0 || !slices.Equal(result.Path, []int{3}) {
+		t.Errorf("got %+v", result)
+	}
+}
+
+func TestWeightedSearches(t *testing.T) {
+	grid, start, goal := readSearchMaze(t)
+
+	// Stepping down costs 5, and the only way through steps down 4 times
+	neighbors := func(p Point) []Neighbor[Point] {
+		moves := make([]Neighbor[Point], 0, 4)
+		for _, n := range openNeighbors(grid)(p) {
+			cost := 1
+			if n.Row > p.Row {
+				cost = 5
+			}
+			moves = append(moves, Neighbor[Point]{n, cost})
+		}
+		return moves
+	}
+	isGoal := func(p Point) bool { return p == goal }
+	heuristic := func(p Point) int { return Manhattan(p, goal) }
+
+	dijkstra := Dijkstra(start, neighbors, isGoal)
+	astar := AStar(start, neighbors, isGoal, heuristic)
+
+	for name, result := range map[string]SearchResult[Point]{"dijkstra": dijkstra, "astar": astar} {
+		t.Run(name, func(t *testing.T) {
+			if !result.Found || result.Cost != 27 {
+				t.Fatalf("got found %t cost %d, want found with cost 27", result.Found, result.Cost)
+			}
+			checkPath(t, grid, result.Path, start, goal)
+
+			cost := 0
+			for i := 1; i < len(result.Path); i++ {
+				cost++
+				if result.Path[i].Row > result.Path[i-1].Row {
+					cost += 4
+				}
+			}
+			if cost != result.Cost {
+				t.Errorf("path costs %d, result says %d", cost, result.Cost)
+			}
+		})
+	}
+
+	if astar.Visited > dijkstra.Visited {
+		t.Errorf("A* visited %d states, more than Dijkstra's %d", astar.Visited, dijkstra.Visited)
+	}
+
+	if cost := AStarCost(start, neighbors, isGoal, heuristic); cost.Cost != astar.Cost || cost.Visited != astar.Visited || cost.Path != nil {
+		t.Errorf("AStarCost got cost %d after %d states with path %v, want the same as AStar without the path",
+			cost.Cost, cost.Visited, cost.Path)
+	}
+}
+
+func TestDijkstraPrefersCheapOverShort(t *testing.T) {
+	// 0 -> 3 directly costs 10, but 0 -> 1 -> 2 -> 3 costs 3
+	edges := map[int][]Neighbor[int]{
+		0: {{3, 10}, {1, 1}},
+		1: {{2, 1}},
+		2: {{3, 1}},
+	}
+	result := Dijkstra(0, func(n int) []Neighbor[int] { return edges[n] }, func(n int) bool { return n == 3 })
+
+	if result.Cost != 3 || !slices.Equal(result.Path, []int{0, 1, 2, 3}) {
+		t.Errorf("got cost %d path %v, want cost 3 path [0 1 2 3]", result.Cost, result.Path)
+	}
+}
+
+func TestReachable(t *testing.T) {
+	grid, start, _ := readSearchMaze(t)
+	reached := Reachable(start, openNeighbors(grid))
+
+	want := SetOf(grid.FindFunc(func(ch rune) bool { return ch != '#' })...)
+	if !reached.Equal(want) {
+		t.Errorf("reached %d squares, want %d", reached.Len(), want.Len())
+	}
+}
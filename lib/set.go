@@ -0,0 +1,156 @@
+package lib
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Set is an unordered collection of distinct values. Every element is a key
+// in the map, so len works as expected.
+type Set[T comparable] map[T]struct{}
+
+func NewSet[T comparable]() Set[T] {
+	return make(Set[T])
+}
+
+// SetOf returns a set holding vals, dropping any duplicates.
+func SetOf[T comparable](vals ...T) Set[T] {
+	s := make(Set[T], len(vals))
+	for _, val := range vals {
+		s[val] = struct{}{}
+	}
+	return s
+}
+
+// Add inserts val, reporting whether it wasn't already in the set.
+func (self *Set[T]) Add(val T) bool {
+	s := *self
+	_, exists := s[val]
+	s[val] = struct{}{}
+	return !exists
+}
+
+func (self Set[T]) Contains(val T) bool {
+	_, exists := self[val]
+	return exists
+}
+
+// Remove deletes val, reporting whether it was in the set.
+func (self *Set[T]) Remove(val T) bool {
+	s := *self
+	_, exists := s[val]
+	delete(s, val)
+	return exists
+}
+
+// Delete removes every one of vals that's in the set.
+func (self *Set[T]) Delete(vals ...T) {
+	s := *self
+	for _, val := range vals {
+		delete(s, val)
+	}
+}
+
+func (self Set[T]) Len() int {
+	return len(self)
+}
+
+func (self Set[T]) Clone() Set[T] {
+	clone := make(Set[T], len(self))
+	for val := range self {
+		clone[val] = struct{}{}
+	}
+	return clone
+}
+
+// Union returns a new set with the elements of both sets.
+func (self Set[T]) Union(other Set[T]) Set[T] {
+	union := self.Clone()
+	for val := range other {
+		union[val] = struct{}{}
+	}
+	return union
+}
+
+// Intersection returns a new set with the elements in both sets.
+func (self Set[T]) Intersection(other Set[T]) Set[T] {
+	// Only the smaller set needs walking
+	small, large := self, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+
+	intersection := make(Set[T])
+	for val := range small {
+		if large.Contains(val) {
+			intersection[val] = struct{}{}
+		}
+	}
+	return intersection
+}
+
+// Difference returns a new set with the elements of self that aren't in
+// other.
+func (self Set[T]) Difference(other Set[T]) Set[T] {
+	difference := make(Set[T])
+	for val := range self {
+		if !other.Contains(val) {
+			difference[val] = struct{}{}
+		}
+	}
+	return difference
+}
+
+// SymmetricDifference returns a new set with the elements in exactly one of
+// the sets.
+func (self Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	difference := self.Difference(other)
+	for val := range other {
+		if !self.Contains(val) {
+			difference[val] = struct{}{}
+		}
+	}
+	return difference
+}
+
+// IsSubset reports whether every element of self is also in other.
+func (self Set[T]) IsSubset(other Set[T]) bool {
+	if len(self) > len(other) {
+		return false
+	}
+
+	for val := range self {
+		if !other.Contains(val) {
+			return false
+		}
+	}
+	return true
+}
+
+func (self Set[T]) Equal(other Set[T]) bool {
+	return len(self) == len(other) && self.IsSubset(other)
+}
+
+// Slice returns the elements in no particular order.
+func (self Set[T]) Slice() []T {
+	vals := make([]T, 0, len(self))
+	for val := range self {
+		vals = append(vals, val)
+	}
+	return vals
+}
+
+// SortedFunc returns the elements ordered by compare, for iterating over a
+// set deterministically.
+func (self Set[T]) SortedFunc(compare func(a, b T) int) []T {
+	vals := self.Slice()
+	slices.SortFunc(vals, compare)
+	return vals
+}
+
+// Sorted returns the elements of a set of ordered values in ascending order.
+func Sorted[T cmp.Ordered](set Set[T]) []T {
+	vals := set.Slice()
+	slices.Sort(vals)
+	return vals
+}
@@ -0,0 +1,107 @@
+package lib
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSetAddRemove(t *testing.T) {
+	set := NewSet[string]()
+
+	if !set.Add("a") || set.Add("a") {
+		t.Errorf("Add should only report true for a new element")
+	}
+	set.Add("b")
+
+	if !set.Remove("a") || set.Remove("a") {
+		t.Errorf("Remove should only report true for a present element")
+	}
+
+	// Removing used to leave the key behind, so the length never went down
+	if len(set) != 1 || set.Len() != 1 {
+		t.Errorf("got length %d, want 1", len(set))
+	}
+
+	set.Delete("b", "c")
+	if set.Len() != 0 || set.Contains("b") {
+		t.Errorf("Delete left %v", set.Slice())
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a := SetOf(1, 2, 3, 4)
+	b := SetOf(3, 4, 5)
+
+	tests := []struct {
+		name string
+		got  Set[int]
+		want []int
+	}{
+		{"union", a.Union(b), []int{1, 2, 3, 4, 5}},
+		{"intersection", a.Intersection(b), []int{3, 4}},
+		{"difference", a.Difference(b), []int{1, 2}},
+		{"reverse difference", b.Difference(a), []int{5}},
+		{"symmetric difference", a.SymmetricDifference(b), []int{1, 2, 5}},
+		{"empty intersection", a.Intersection(SetOf(9)), nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Sorted(test.got); !slices.Equal(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+
+	// None of the operations should touch their operands
+	if !a.Equal(SetOf(1, 2, 3, 4)) || !b.Equal(SetOf(3, 4, 5)) {
+		t.Errorf("operands changed: %v, %v", Sorted(a), Sorted(b))
+	}
+}
+
+func TestSetComparisons(t *testing.T) {
+	tests := []struct {
+		a, b          Set[int]
+		subset, equal bool
+	}{
+		{SetOf[int](), SetOf(1), true, false},
+		{SetOf(1, 2), SetOf(2, 1), true, true},
+		{SetOf(1, 2), SetOf(1, 2, 3), true, false},
+		{SetOf(1, 4), SetOf(1, 2, 3), false, false},
+		{SetOf(1, 2, 3), SetOf(1, 2), false, false},
+	}
+
+	for _, test := range tests {
+		if got := test.a.IsSubset(test.b); got != test.subset {
+			t.Errorf("%v.IsSubset(%v) = %t", Sorted(test.a), Sorted(test.b), got)
+		}
+		if got := test.a.Equal(test.b); got != test.equal {
+			t.Errorf("%v.Equal(%v) = %t", Sorted(test.a), Sorted(test.b), got)
+		}
+	}
+}
+
+func TestSetClone(t *testing.T) {
+	set := SetOf("a", "b")
+	clone := set.Clone()
+	clone.Add("c")
+
+	if set.Contains("c") {
+		t.Errorf("adding to a clone changed the original")
+	}
+}
+
+func TestSetSortedFunc(t *testing.T) {
+	set := SetOf("pear", "fig", "banana", "kiwi")
+	got := set.SortedFunc(func(a, b string) int {
+		if len(a) != len(b) {
+			return len(a) - len(b)
+		}
+		return strings.Compare(a, b)
+	})
+
+	if want := []string{"fig", "kiwi", "pear", "banana"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
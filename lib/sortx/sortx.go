@@ -0,0 +1,219 @@
+// Package sortx is where the sorting algorithms live, rather than each day
+// growing its own quicksort. Everything sorts in place and is driven by a
+// comparator in the style of slices.SortFunc, which ByKey can build from a
+// key function, except RadixSort which works straight from an int key.
+package sortx
+
+import (
+	"cmp"
+	"math/bits"
+)
+
+// Below this length, insertion sort beats anything cleverer.
+const insertionThreshold = 12
+
+// ByKey makes a comparator that orders values by the key they map to.
+func ByKey[T any, K cmp.Ordered](key func(T) K) func(a, b T) int {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// Introsort sorts s with quicksort, using a median of three pivot so sorted
+// input isn't a worst case. If partitioning goes badly enough to recurse
+// deeper than 2*log2(n) anyway, it switches to heapsort, so it never takes
+// more than O(n log n). It isn't stable.
+func Introsort[T any](s []T, compare func(a, b T) int) {
+	introsort(s, compare, 2*bits.Len(uint(len(s))))
+}
+
+func introsort[T any](s []T, compare func(a, b T) int, depth int) {
+	for len(s) > insertionThreshold {
+		if depth == 0 {
+			heapsort(s, compare)
+			return
+		}
+		depth--
+
+		// Recurse into the smaller side and loop on the larger, so the stack
+		// never gets more than log2(n) deep
+		p := partition(s, compare)
+		if p < len(s)-p {
+			introsort(s[:p], compare, depth)
+			s = s[p+1:]
+		} else {
+			introsort(s[p+1:], compare, depth)
+			s = s[:p]
+		}
+	}
+
+	insertionSort(s, compare)
+}
+
+// Partitions s around the median of its first, middle and last elements,
+// returning where the pivot ends up. Elements equal to the pivot can land
+// on either side, which keeps runs of duplicates from unbalancing it.
+func partition[T any](s []T, compare func(a, b T) int) int {
+	mid, last := len(s)/2, len(s)-1
+	if compare(s[mid], s[0]) < 0 {
+		s[0], s[mid] = s[mid], s[0]
+	}
+	if compare(s[last], s[mid]) < 0 {
+		s[mid], s[last] = s[last], s[mid]
+		if compare(s[mid], s[0]) < 0 {
+			s[0], s[mid] = s[mid], s[0]
+		}
+	}
+	s[0], s[mid] = s[mid], s[0]
+
+	pivot := s[0]
+	i, j := 1, last
+	for {
+		for i <= j && compare(s[i], pivot) < 0 {
+			i++
+		}
+		for i <= j && compare(s[j], pivot) > 0 {
+			j--
+		}
+		if i >= j {
+			break
+		}
+
+		s[i], s[j] = s[j], s[i]
+		i++
+		j--
+	}
+
+	s[0], s[j] = s[j], s[0]
+	return j
+}
+
+func insertionSort[T any](s []T, compare func(a, b T) int) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && compare(s[j], s[j-1]) < 0; j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
+
+func heapsort[T any](s []T, compare func(a, b T) int) {
+	for i := len(s)/2 - 1; i >= 0; i-- {
+		siftDown(s, i, compare)
+	}
+	for end := len(s) - 1; end > 0; end-- {
+		s[0], s[end] = s[end], s[0]
+		siftDown(s[:end], 0, compare)
+	}
+}
+
+// Moves s[i] down a max-heap until neither child is bigger.
+func siftDown[T any](s []T, i int, compare func(a, b T) int) {
+	for {
+		child := 2*i + 1
+		if child >= len(s) {
+			return
+		}
+		if child+1 < len(s) && compare(s[child+1], s[child]) > 0 {
+			child++
+		}
+		if compare(s[child], s[i]) <= 0 {
+			return
+		}
+
+		s[i], s[child] = s[child], s[i]
+		i = child
+	}
+}
+
+// MergeSort sorts s stably, so equal elements keep their order. It needs a
+// buffer half the length of s, and skips the merge wherever two halves are
+// already in order, which makes nearly sorted input close to linear.
+func MergeSort[T any](s []T, compare func(a, b T) int) {
+	mergeSort(s, make([]T, (len(s)+1)/2), compare)
+}
+
+func mergeSort[T any](s, buf []T, compare func(a, b T) int) {
+	if len(s) <= insertionThreshold {
+		insertionSort(s, compare)
+		return
+	}
+
+	mid := len(s) / 2
+	mergeSort(s[:mid], buf, compare)
+	mergeSort(s[mid:], buf, compare)
+	if compare(s[mid-1], s[mid]) <= 0 {
+		return
+	}
+
+	// Merge a copy of the left half with the right half, filling s from the
+	// front. The write position never passes the right half's read position,
+	// and whatever's left of the right half is already where it belongs.
+	left := buf[:mid]
+	copy(left, s[:mid])
+
+	i, j, k := 0, mid, 0
+	for i < len(left) && j < len(s) {
+		// Taking from the left on ties is what makes it stable
+		if compare(s[j], left[i]) < 0 {
+			s[k] = s[j]
+			j++
+		} else {
+			s[k] = left[i]
+			i++
+		}
+		k++
+	}
+	copy(s[k:], left[i:])
+}
+
+// RadixSort sorts s stably by an int key, a byte at a time from the least
+// significant end. It takes O(n) time for each of the 8 bytes, skipping any
+// byte every key shares, and needs a copy of s and its keys as scratch.
+func RadixSort[T any](s []T, key func(T) int) {
+	n := len(s)
+	if n < 2 {
+		return
+	}
+
+	// Flipping the sign bit puts negative keys before positive ones when
+	// they're compared as unsigned
+	keys := make([]uint64, n)
+	for i, val := range s {
+		keys[i] = uint64(key(val)) ^ (1 << 63)
+	}
+
+	src, dst := s, make([]T, n)
+	srcKeys, dstKeys := keys, make([]uint64, n)
+
+	for shift := 0; shift < 64; shift += 8 {
+		var counts [256]int
+		for _, k := range srcKeys {
+			counts[byte(k>>shift)]++
+		}
+		if counts[byte(srcKeys[0]>>shift)] == n {
+			continue
+		}
+
+		// Turn the counts into where each digit's elements start
+		start := 0
+		for digit, count := range counts {
+			counts[digit] = start
+			start += count
+		}
+
+		for i, k := range srcKeys {
+			digit := byte(k >> shift)
+			dst[counts[digit]] = src[i]
+			dstKeys[counts[digit]] = k
+			counts[digit]++
+		}
+
+		src, dst = dst, src
+		srcKeys, dstKeys = dstKeys, srcKeys
+	}
+
+	// After an odd number of passes the sorted values are in the scratch copy
+	if &src[0] != &s[0] {
+		copy(s, src)
+	}
+}
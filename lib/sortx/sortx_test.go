@@ -0,0 +1,156 @@
+package sortx
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+type record struct {
+	key, order int
+}
+
+func compareRecords(a, b record) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+func recordKey(r record) int {
+	return r.key
+}
+
+// Inputs that tend to break quicksorts: sorted, reversed, all the same, few
+// distinct values and organ pipes, plus plain random ones.
+func inputs(n int) map[string][]int {
+	rng := rand.New(rand.NewSource(int64(n)))
+
+	random, sorted, reversed, equal, few, pipe := make([]int, n), make([]int, n), make([]int, n), make([]int, n), make([]int, n), make([]int, n)
+	for i := 0; i < n; i++ {
+		random[i] = rng.Intn(2*n+1) - n
+		sorted[i] = i
+		reversed[i] = n - i
+		equal[i] = 7
+		few[i] = rng.Intn(3)
+		pipe[i] = min(i, n-i)
+	}
+
+	return map[string][]int{
+		"random":   random,
+		"sorted":   sorted,
+		"reversed": reversed,
+		"equal":    equal,
+		"few":      few,
+		"pipe":     pipe,
+	}
+}
+
+// Wraps each int with its position, so stability can be checked.
+func records(ints []int) []record {
+	records := make([]record, len(ints))
+	for i, n := range ints {
+		records[i] = record{n, i}
+	}
+	return records
+}
+
+func TestSorts(t *testing.T) {
+	sorts := map[string]struct {
+		sort   func([]record)
+		stable bool
+	}{
+		"Introsort": {func(s []record) { Introsort(s, compareRecords) }, false},
+		"MergeSort": {func(s []record) { MergeSort(s, compareRecords) }, true},
+		"RadixSort": {func(s []record) { RadixSort(s, recordKey) }, true},
+		"heapsort":  {func(s []record) { heapsort(s, compareRecords) }, false},
+	}
+
+	for _, n := range []int{0, 1, 2, 5, 12, 13, 100, 1000} {
+		for inputName, input := range inputs(n) {
+			want := records(input)
+			slices.SortStableFunc(want, compareRecords)
+
+			for sortName, sort := range sorts {
+				got := records(input)
+				sort.sort(got)
+
+				if sort.stable {
+					if !slices.Equal(got, want) {
+						t.Errorf("%s of %d %s: got %v, want %v", sortName, n, inputName, got, want)
+					}
+				} else if !slices.EqualFunc(got, want, func(a, b record) bool { return a.key == b.key }) {
+					t.Errorf("%s of %d %s: got %v, want %v", sortName, n, inputName, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestRadixSortExtremes(t *testing.T) {
+	got := []int{0, -1, 1 << 62, -(1 << 62), 255, 256, -256, 9_223_372_036_854_775_807, -9_223_372_036_854_775_808}
+	want := slices.Clone(got)
+	slices.Sort(want)
+
+	RadixSort(got, func(n int) int { return n })
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestByKey(t *testing.T) {
+	words := []string{"pear", "fig", "banana", "kiwi", "apple"}
+	MergeSort(words, ByKey(func(s string) int { return len(s) }))
+
+	want := []string{"fig", "pear", "kiwi", "apple", "banana"}
+	if !slices.Equal(words, want) {
+		t.Errorf("got %v, want %v", words, want)
+	}
+}
+
+// A comparator that counts its calls, to check none of the awkward inputs make
+// Introsort quadratic.
+func TestIntrosortIsNeverQuadratic(t *testing.T) {
+	const n = 10_000
+	for name, input := range inputs(n) {
+		comparisons := 0
+		Introsort(input, func(a, b int) int {
+			comparisons++
+			return cmp.Compare(a, b)
+		})
+
+		// n*log2(n) is about 140,000, where a quadratic sort would need 50 million
+		if comparisons > 4*140_000 {
+			t.Errorf("%s: %d comparisons to sort %d values", name, comparisons, n)
+		}
+	}
+}
+
+func BenchmarkSorts(b *testing.B) {
+	compareInts := func(a, b int) int { return cmp.Compare(a, b) }
+	identity := func(n int) int { return n }
+
+	sorts := []struct {
+		name string
+		sort func([]int)
+	}{
+		{"slices.SortFunc", func(s []int) { slices.SortFunc(s, compareInts) }},
+		{"Introsort", func(s []int) { Introsort(s, compareInts) }},
+		{"MergeSort", func(s []int) { MergeSort(s, compareInts) }},
+		{"RadixSort", func(s []int) { RadixSort(s, identity) }},
+	}
+
+	const n = 100_000
+	data := inputs(n)
+	scratch := make([]int, n)
+
+	for _, inputName := range []string{"random", "sorted", "few"} {
+		for _, sort := range sorts {
+			b.Run(fmt.Sprintf("%s/%s", inputName, sort.name), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					copy(scratch, data[inputName])
+					sort.sort(scratch)
+				}
+			})
+		}
+	}
+}